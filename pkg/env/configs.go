@@ -0,0 +1,61 @@
+package env
+
+// Config holds the options used by Parse to look up environment variables.
+type Config struct {
+	Prefix string // Prefix is prepended to every env name before it is looked up
+
+	CaseInsensitive bool // CaseInsensitive matches env names regardless of their case
+
+	Expand       bool // Expand substitutes ${VAR} references in values with other env vars
+	StrictExpand bool // StrictExpand fails on references to unset env vars instead of expanding them to ""
+}
+
+type Option func(cfg *Config)
+
+func WithOptions(opts ...Option) Option {
+	return func(cfg *Config) {
+		for _, opt := range opts {
+			opt(cfg)
+		}
+	}
+}
+
+// WithPrefix prepends prefix to every env name, including each name of a multi-name
+// tag, so that `env:"PORT"` is looked up as MYAPP_PORT with WithPrefix("MYAPP_").
+func WithPrefix(prefix string) Option {
+	return func(cfg *Config) {
+		cfg.Prefix = prefix
+	}
+}
+
+// WithCaseInsensitive matches env names regardless of their case, for deployment systems
+// that change the case of env names. An exact match is still preferred.
+func WithCaseInsensitive() Option {
+	return func(cfg *Config) {
+		cfg.CaseInsensitive = true
+	}
+}
+
+// WithExpand substitutes $VAR and ${VAR} references in values, including defaults, with the
+// values of other env vars before they are converted, e.g. URL=https://${HOST}:${PORT}/.
+// ${VAR:-default} falls back to default when VAR is unset or empty, and $$ is a literal $.
+// References to unset env vars expand to "". Values of fields tagged secret and values read
+// from a <NAME>_FILE are used verbatim, so that a $ in a password is kept.
+func WithExpand() Option {
+	return func(cfg *Config) {
+		cfg.Expand = true
+	}
+}
+
+// WithStrictExpand is WithExpand, but a reference to an unset env var without a fallback fails
+// with ErrUnresolvedReference.
+func WithStrictExpand() Option {
+	return func(cfg *Config) {
+		cfg.Expand = true
+		cfg.StrictExpand = true
+	}
+}
+
+func defaultConfig() *Config {
+	return &Config{}
+}
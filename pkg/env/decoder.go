@@ -0,0 +1,62 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// DecodeFunc parses an env value into a value of the type it is registered for.
+type DecodeFunc func(value string) (interface{}, error)
+
+var (
+	decodersMu sync.RWMutex
+	decoders   = make(map[reflect.Type]DecodeFunc)
+)
+
+// RegisterDecoder registers decode to parse the fields of type t, e.g. third-party types that
+// can't implement Unmarshaler. Registered decoders are consulted before Unmarshaler and the
+// built-in types, for fields, pointers, map keys and map values of type t. Registering a decoder
+// for a type replaces the previous one and registering a nil decoder removes it. It is safe to
+// call concurrently with Parse.
+func RegisterDecoder(t reflect.Type, decode DecodeFunc) {
+	if t == nil {
+		panic("env: RegisterDecoder called with a nil type")
+	}
+	decodersMu.Lock()
+	defer decodersMu.Unlock()
+	if decode == nil {
+		delete(decoders, t)
+		return
+	}
+	decoders[t] = decode
+}
+
+// decoder returns the decoder registered for t, if any.
+func decoder(t reflect.Type) (DecodeFunc, bool) {
+	decodersMu.RLock()
+	defer decodersMu.RUnlock()
+	decode, ok := decoders[t]
+	return decode, ok
+}
+
+// setDecoded stores in f the value decode parses from value. The decoded value must be
+// assignable to the type t of f, a nil value sets f to its zero value.
+func setDecoded(t reflect.Type, f reflect.Value, value string, decode DecodeFunc) error {
+	decoded, err := decode(value)
+	if err != nil {
+		return err
+	}
+	if decoded == nil {
+		f.Set(reflect.Zero(t))
+		return nil
+	}
+	dv := reflect.ValueOf(decoded)
+	if !dv.Type().AssignableTo(t) {
+		return ErrInvalidValue{
+			Value: fmt.Sprintf("decoder for %s returned a %s", t, dv.Type()),
+		}
+	}
+	f.Set(dv)
+	return nil
+}
@@ -0,0 +1,133 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// LoadFile reads the KEY=VALUE lines of a .env file. Blank lines and lines starting with # are
+// skipped, and an "export " prefix is ignored. Values may be double quoted, with \n, \t, \" and \\
+// escapes, or single quoted, taken literally. Unquoted values end at a # preceded by whitespace.
+// Parameters:
+//
+//	path - string [The path of the .env file]
+//
+// Returns:
+//
+//   - map[string]string [The values by key, a key repeated later in the file overrides earlier ones]
+//   - error [The error of reading the file, or ErrInvalidEnvSet naming the malformed line, without its value]
+func LoadFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string)
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, "export "); ok {
+			line = strings.TrimSpace(rest)
+		}
+		es, err := envToEnvSet([]string{line})
+		if err != nil {
+			return nil, ErrInvalidEnvSet{Value: fmt.Sprintf("%s line %d", path, i+1)}
+		}
+		for key, raw := range es {
+			key = strings.TrimSpace(key)
+			value, ok := dotenvValue(strings.TrimSpace(raw))
+			if key == "" || strings.ContainsAny(key, " \t") || !ok {
+				return nil, ErrInvalidEnvSet{Value: fmt.Sprintf("%s line %d", path, i+1)}
+			}
+			values[key] = value
+		}
+	}
+	return values, nil
+}
+
+// ParseWithFile parses the values of the .env file at path merged with os.Environ into the value
+// pointed to by cfg, like Parse. A variable set in the environment takes precedence over the file.
+// Parameters:
+//
+//	path - string [The path of the .env file]
+//	cfg - interface{} [A pointer to the struct to parse into]
+//	opts - ...Option [The options used to look up env names]
+//
+// Returns:
+//
+//   - error
+func ParseWithFile(path string, cfg interface{}, opts ...Option) error {
+	conf := defaultConfig()
+	for _, opt := range opts {
+		opt(conf)
+	}
+	fileValues, err := LoadFile(path)
+	if err != nil {
+		return err
+	}
+	es, err := envToEnvSet(os.Environ())
+	if err != nil {
+		return err
+	}
+	for key, value := range fileValues {
+		if _, ok := es[key]; !ok {
+			es[key] = value
+		}
+	}
+	return unmarshalWithConfig(es, cfg, conf)
+}
+
+// dotenvValue unquotes the value of a .env line. It reports false for an unterminated quote or
+// for text other than a comment after the closing quote.
+func dotenvValue(raw string) (string, bool) {
+	if raw == "" {
+		return "", true
+	}
+	switch raw[0] {
+	case '\'':
+		end := strings.IndexByte(raw[1:], '\'')
+		if end < 0 {
+			return "", false
+		}
+		return raw[1 : end+1], trailingComment(raw[end+2:])
+	case '"':
+		var b strings.Builder
+		for i := 1; i < len(raw); i++ {
+			switch c := raw[i]; {
+			case c == '"':
+				return b.String(), trailingComment(raw[i+1:])
+			case c == '\\' && i+1 < len(raw):
+				i++
+				switch raw[i] {
+				case 'n':
+					b.WriteByte('\n')
+				case 't':
+					b.WriteByte('\t')
+				case '"', '\\':
+					b.WriteByte(raw[i])
+				default:
+					b.WriteByte('\\')
+					b.WriteByte(raw[i])
+				}
+			default:
+				b.WriteByte(c)
+			}
+		}
+		return "", false
+	}
+	if i := strings.Index(raw, " #"); i >= 0 {
+		raw = raw[:i]
+	}
+	if i := strings.Index(raw, "\t#"); i >= 0 {
+		raw = raw[:i]
+	}
+	return strings.TrimSpace(raw), true
+}
+
+// trailingComment reports whether rest, the text after a quoted value, is empty or a comment.
+func trailingComment(rest string) bool {
+	rest = strings.TrimSpace(rest)
+	return rest == "" || strings.HasPrefix(rest, "#")
+}
@@ -2,15 +2,20 @@ package env
 
 //go:generate mockgen -source=env.go -destination=mocks/mock-env.go -package=mocks
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 )
 
-var unmarshalType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+var (
+	unmarshalType  = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+)
 
 // Unmarshaler is the interface implemented by types that can unmarshal an
 // environment variable value representation of themselves. The input can be
@@ -36,6 +41,11 @@ type Marshaler interface {
 // If the field has a type that is unsupported, unmarshal returns
 // ErrUnsupportedType.
 func unmarshal(es envSet, v interface{}) error {
+	return unmarshalWithConfig(es, v, defaultConfig())
+}
+
+// unmarshalWithConfig is unmarshal with env names looked up according to cfg.
+func unmarshalWithConfig(es envSet, v interface{}, cfg *Config) error {
 	rv := reflect.ValueOf(v)
 	if rv.Kind() != reflect.Ptr || rv.IsNil() {
 		return ErrInvalidValue{
@@ -49,19 +59,22 @@ func unmarshal(es envSet, v interface{}) error {
 			Value: fmt.Sprintf("%T", v),
 		}
 	}
+	return unmarshalStruct(es, rv, cfg)
+}
 
+// unmarshalStruct sets the fields of the addressable struct rv. The fields of embedded structs,
+// including unexported ones and pointers, are set as if they were declared in rv, the way Go
+// promotes them.
+func unmarshalStruct(es envSet, rv reflect.Value, cfg *Config) error {
 	t := rv.Type()
 	for i := 0; i < rv.NumField(); i++ {
 		valueField := rv.Field(i)
-		switch valueField.Kind() {
-		case reflect.Struct:
-			if !valueField.Addr().CanInterface() {
-				continue
+		if embedded, ok := embeddedStruct(t.Field(i), valueField); ok {
+			if err := unmarshalStruct(es, embedded, cfg); err != nil {
+				return err
 			}
-
-			iFace := valueField.Addr().Interface()
-			err := unmarshal(es, iFace)
-			if err != nil {
+		} else if valueField.Kind() == reflect.Struct && valueField.Addr().CanInterface() {
+			if err := unmarshalStruct(es, valueField, cfg); err != nil {
 				return err
 			}
 		}
@@ -78,40 +91,201 @@ func unmarshal(es envSet, v interface{}) error {
 			}
 		}
 
-		envTag := parseTag(tag)
+		envTag, err := parseTag(fieldName(t, typeField), tag)
+		if err != nil {
+			return err
+		}
+		for i, envKey := range envTag.Keys {
+			envTag.Keys[i] = cfg.Prefix + envKey
+		}
+		for i, alias := range envTag.Aliases {
+			envTag.Aliases[i] = cfg.Prefix + alias
+		}
+		key := envTag.key(typeField.Name)
 
 		var (
 			envValue string
 			ok       bool
+			fromFile bool
 		)
-		for _, envKey := range envTag.Keys {
-			envValue, ok = es[envKey]
+		for _, envKey := range append(envTag.Keys, envTag.Aliases...) {
+			envValue, ok = lookup(es, envKey, cfg)
 			if ok {
 				break
 			}
 		}
 
+		if envTag.File && envValue == "" && len(envTag.Keys) > 0 {
+			fileValue, found, err := readFileValue(es, envTag.Keys[0], cfg)
+			if err != nil {
+				return err
+			}
+			if found {
+				envValue, ok, fromFile = fileValue, true, true
+			}
+		}
+
 		if !ok {
 			if envTag.Default != "" {
 				envValue = envTag.Default
 			} else if envTag.Required {
-				return &ErrMissingRequiredValue{Value: envTag.Keys[0]}
+				return &ErrMissingRequiredValue{Value: key}
 			} else {
 				continue
 			}
 		}
 
-		err := set(typeField.Type, valueField, envValue)
+		// secrets are used verbatim, a $ in a password is not a reference
+		if cfg.Expand && !fromFile && !envTag.Secret {
+			envValue, err = expand(es, envValue, key, cfg)
+			if err != nil {
+				return err
+			}
+		}
+
+		if envTag.JSON {
+			err = setJSON(typeField.Type, valueField, envValue, key)
+		} else if typeField.Type.Kind() == reflect.Map {
+			err = setMap(typeField.Type, valueField, envValue, envTag, typeField.Name)
+		} else {
+			err = set(typeField.Type, valueField, envValue)
+		}
 		if err != nil {
 			return err
 		}
+		if err := validate(valueField, envValue, envTag, key); err != nil {
+			return err
+		}
 		delete(es, tag)
 	}
 
+	if !rv.Addr().CanInterface() {
+		return nil
+	}
+	if validator, ok := rv.Addr().Interface().(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// embeddedStruct returns the struct embedded by field, allocating it when it is embedded as a
+// nil pointer. It reports false when field is not an embedded struct, or is an unexported
+// pointer that can't be allocated.
+func embeddedStruct(field reflect.StructField, v reflect.Value) (reflect.Value, bool) {
+	if !field.Anonymous {
+		return reflect.Value{}, false
+	}
+	switch {
+	case v.Kind() == reflect.Struct:
+		return v, true
+	case v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Struct:
+		if v.IsNil() {
+			if !v.CanSet() {
+				return reflect.Value{}, false
+			}
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return v.Elem(), true
+	}
+	return reflect.Value{}, false
+}
+
+// lookup returns the value of key in es. With cfg.CaseInsensitive an exact match is
+// preferred, otherwise the first in sorted order of the keys that only differ in case is used,
+// so the result doesn't depend on map iteration.
+func lookup(es envSet, key string, cfg *Config) (string, bool) {
+	if value, ok := es[key]; ok {
+		return value, true
+	}
+	if !cfg.CaseInsensitive {
+		return "", false
+	}
+	match, found := "", false
+	for k := range es {
+		if strings.EqualFold(k, key) && (!found || k < match) {
+			match, found = k, true
+		}
+	}
+	if !found {
+		return "", false
+	}
+	return es[match], true
+}
+
+// readFileValue reads the value of key from the file named by <key>_FILE, the convention used
+// for Docker and Kubernetes secrets. The trailing newline of the file is trimmed. It reports
+// false when <key>_FILE is not set, and returns ErrReadFile when the file can't be read, so that
+// a wrong path does not silently fall back to the default.
+func readFileValue(es envSet, key string, cfg *Config) (string, bool, error) {
+	path, ok := lookup(es, key+"_FILE", cfg)
+	if !ok || path == "" {
+		return "", false, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, ErrReadFile{Value: key + "_FILE", Err: err}
+	}
+	return strings.TrimRight(string(data), "\r\n"), true, nil
+}
+
+// setJSON stores a value tagged with the "json" option. json.RawMessage fields keep the
+// raw bytes so that decoding can be deferred, any other type is decoded with json.Unmarshal.
+// In both cases the value must be valid JSON, otherwise ErrInvalidValue is returned.
+func setJSON(t reflect.Type, f reflect.Value, value string, key string) error {
+	if !json.Valid([]byte(value)) {
+		return ErrInvalidValue{
+			Value: fmt.Sprintf("%s is not valid json", key),
+		}
+	}
+	if t == rawMessageType {
+		f.SetBytes(json.RawMessage(value))
+		return nil
+	}
+	ptr := reflect.New(t)
+	if err := json.Unmarshal([]byte(value), ptr.Interface()); err != nil {
+		return ErrInvalidValue{
+			Value: fmt.Sprintf("%s: %v", key, err),
+		}
+	}
+	f.Set(ptr.Elem())
+	return nil
+}
+
+// setMap stores a list of key/value pairs, e.g. "env=prod,team=core", in a map field.
+// Pairs are split on the tag's sep option and keys from values on its kvsep option.
+// Keys and values are parsed like any other field, a pair without a key/value
+// separator returns ErrInvalidValue naming the field.
+func setMap(t reflect.Type, f reflect.Value, value string, envTag tag, name string) error {
+	m := reflect.MakeMap(t)
+	for _, pair := range strings.Split(value, envTag.sep()) {
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, envTag.kvSep(), 2)
+		if len(kv) != 2 {
+			return ErrInvalidValue{
+				Value: fmt.Sprintf("field %s has malformed pair %q", name, pair),
+			}
+		}
+		k := reflect.New(t.Key()).Elem()
+		if err := set(t.Key(), k, kv[0]); err != nil {
+			return err
+		}
+		v := reflect.New(t.Elem()).Elem()
+		if err := set(t.Elem(), v, kv[1]); err != nil {
+			return err
+		}
+		m.SetMapIndex(k, v)
+	}
+	f.Set(m)
 	return nil
 }
 
 func set(t reflect.Type, f reflect.Value, value string) error {
+	if decode, ok := decoder(t); ok {
+		return setDecoded(t, f, value, decode)
+	}
+
 	// See if the type implements Unmarshaler and use that first,
 	// otherwise, fallback to the previous logic
 	var isUnmarshaler bool
@@ -154,7 +328,7 @@ func set(t reflect.Type, f reflect.Value, value string) error {
 	case reflect.String:
 		f.SetString(value)
 	case reflect.Bool:
-		v, err := strconv.ParseBool(value)
+		v, err := parseBool(value)
 		if err != nil {
 			return err
 		}
@@ -202,6 +376,20 @@ func set(t reflect.Type, f reflect.Value, value string) error {
 	return nil
 }
 
+// parseBool parses a bool more leniently than strconv.ParseBool, for the values ops tooling
+// writes. It accepts, regardless of case, 1, t, true, yes, on and enabled as true and 0, f,
+// false, no, off and disabled as false. Any other value fails with the error of
+// strconv.ParseBool.
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "1", "t", "true", "yes", "on", "enabled":
+		return true, nil
+	case "0", "f", "false", "no", "off", "disabled":
+		return false, nil
+	}
+	return strconv.ParseBool(value)
+}
+
 // Unmarshal parses an EnvSet from os.Environ and stores the result
 // in the value pointed to by v. Fields that weren't matched in v are returned
 // in an EnvSet with the remaining environment variables. If v is nil or not a
@@ -229,6 +417,56 @@ func Unmarshal(v interface{}) (envSet, error) {
 	return es, unmarshal(es, v)
 }
 
+// Parse parses os.Environ into the value pointed to by cfg, like Unmarshal,
+// with env names looked up according to the given options.
+// Parameters:
+//
+//	cfg - interface{} [A pointer to the struct to parse into]
+//	opts - ...Option [The options used to look up env names]
+//
+// Returns:
+//
+//   - error
+func Parse(cfg interface{}, opts ...Option) error {
+	conf := defaultConfig()
+	for _, opt := range opts {
+		opt(conf)
+	}
+	es, err := envToEnvSet(os.Environ())
+	if err != nil {
+		return err
+	}
+	return unmarshalWithConfig(es, cfg, conf)
+}
+
+// ParseWithPrefix parses os.Environ into the value pointed to by cfg with prefix
+// prepended to every env name. Defaults and required fields apply after prefixing.
+// Parameters:
+//
+//	prefix - string [The prefix prepended to every env name, e.g. MYAPP_]
+//	cfg - interface{} [A pointer to the struct to parse into]
+//
+// Returns:
+//
+//   - error
+func ParseWithPrefix(prefix string, cfg interface{}) error {
+	return Parse(cfg, WithPrefix(prefix))
+}
+
+// ParseWithExpand parses os.Environ into the value pointed to by cfg like Parse, with
+// ${VAR} references in values expanded as described by WithExpand.
+// Parameters:
+//
+//	cfg - interface{} [A pointer to the struct to parse into]
+//	opts - ...Option [The options used to look up env names, e.g. WithStrictExpand]
+//
+// Returns:
+//
+//   - error
+func ParseWithExpand(cfg interface{}, opts ...Option) error {
+	return Parse(cfg, append([]Option{WithExpand()}, opts...)...)
+}
+
 // Marshal returns an EnvSet of v. If v is nil or not a pointer, Marshal returns
 // an ErrInvalidValue.
 //
@@ -286,27 +524,21 @@ func Marshal(v interface{}) (envSet, error) {
 			continue
 		}
 
-		envKeys := strings.Split(tag, ",")
+		envTag, err := parseTag(fieldName(t, typeField), tag)
+		if err != nil {
+			return nil, err
+		}
+		envKeys := envTag.Keys
 
-		var el interface{}
 		if typeField.Type.Kind() == reflect.Ptr {
 			if valueField.IsNil() {
 				continue
 			}
-			el = valueField.Elem().Interface()
-		} else {
-			el = valueField.Interface()
+			valueField = valueField.Elem()
 		}
-
-		var err error
-		var envValue string
-		if m, ok := el.(Marshaler); ok {
-			envValue, err = m.MarshalEnvironmentValue()
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			envValue = fmt.Sprintf("%v", el)
+		envValue, err := formatValue(valueField, envTag)
+		if err != nil {
+			return nil, err
 		}
 
 		for _, envKey := range envKeys {
@@ -317,21 +549,101 @@ func Marshal(v interface{}) (envSet, error) {
 	return es, nil
 }
 
+// formatValue formats v, the value of a field tagged with envTag, as an env value.
+func formatValue(v reflect.Value, envTag tag) (string, error) {
+	if v.CanInterface() {
+		el := v.Interface()
+		if m, ok := el.(Marshaler); ok {
+			return m.MarshalEnvironmentValue()
+		}
+		if raw, ok := el.(json.RawMessage); ok {
+			return string(raw), nil
+		}
+	}
+	if v.Kind() == reflect.Map {
+		return marshalMap(v, envTag), nil
+	}
+	return fmt.Sprintf("%v", v), nil
+}
+
+// marshalMap formats a map as key/value pairs sorted by key, the inverse of setMap.
+func marshalMap(m reflect.Value, envTag tag) string {
+	pairs := make([]string, 0, m.Len())
+	iter := m.MapRange()
+	for iter.Next() {
+		pairs = append(pairs, fmt.Sprintf("%v%s%v", iter.Key(), envTag.kvSep(), iter.Value()))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, envTag.sep())
+}
+
 type tag struct {
 	Keys     []string
+	Aliases  []string
 	Default  string
 	Required bool
+	JSON     bool
+	File     bool
+	Secret   bool
+	Sep      string
+	KVSep    string
+	Min      string
+	Max      string
+	OneOf    []string
+}
+
+// key returns the env name reported in errors about the field named field: its first env name,
+// or the field name when the tag has none, e.g. `env:"default=x"`.
+func (t tag) key(field string) string {
+	if len(t.Keys) == 0 {
+		return field
+	}
+	return t.Keys[0]
+}
+
+// sep returns the separator between map pairs, a comma by default.
+func (t tag) sep() string {
+	if t.Sep == "" {
+		return ","
+	}
+	return t.Sep
+}
+
+// kvSep returns the separator between a map key and value, "=" by default.
+func (t tag) kvSep() string {
+	if t.KVSep == "" {
+		return "="
+	}
+	return t.KVSep
 }
 
-func parseTag(tagString string) tag {
+// tagOptions are the options accepted in an env tag, listed in the errors about unknown options.
+var tagOptions = []string{"required", "default", "alias", "json", "file", "secret", "sep", "kvsep", "min", "max", "oneof"}
+
+// parseTag parses the env tag of field. It returns ErrUnsupportedField for an unknown key=value
+// option, or for a lowercase name that looks like a misspelled option, e.g. "requird", since
+// it would otherwise silently be used as an env name.
+func parseTag(field string, tagString string) (tag, error) {
 	var t tag
 	envKeys := strings.Split(tagString, ",")
-	for _, key := range envKeys {
+	for i, key := range envKeys {
 		if strings.Contains(key, "=") {
 			keyData := strings.SplitN(key, "=", 2)
 			switch strings.ToLower(keyData[0]) {
 			case "default":
 				t.Default = keyData[1]
+			case "alias":
+				t.Aliases = append(t.Aliases, keyData[1])
+			case "sep":
+				t.Sep = keyData[1]
+			case "kvsep":
+				t.KVSep = keyData[1]
+			case "min":
+				t.Min = keyData[1]
+			case "max":
+				t.Max = keyData[1]
+			case "oneof":
+				t.OneOf = strings.Split(keyData[1], "|")
 			case "required":
 				// Handle required=true/false explicitly
 				switch strings.ToLower(keyData[1]) {
@@ -344,14 +656,75 @@ func parseTag(tagString string) tag {
 					t.Required = true
 				}
 			default:
-				// just ignoring unsupported keys
-				continue
+				return t, unknownOption(field, keyData[0])
 			}
 		} else if strings.ToLower(key) == "required" {
 			t.Required = true
+		} else if strings.ToLower(key) == "json" {
+			t.JSON = true
+		} else if strings.ToLower(key) == "file" {
+			t.File = true
+		} else if strings.ToLower(key) == "secret" {
+			t.Secret = true
+		} else if i > 0 && misspelledOption(key) {
+			return t, unknownOption(field, key)
 		} else {
 			t.Keys = append(t.Keys, key)
 		}
 	}
-	return t
+	return t, nil
+}
+
+// fieldName returns the name of field of the struct type t used in errors, e.g. Config.Port.
+func fieldName(t reflect.Type, field reflect.StructField) string {
+	if t.Name() == "" {
+		return field.Name
+	}
+	return t.Name() + "." + field.Name
+}
+
+func unknownOption(field string, option string) error {
+	return ErrUnsupportedField{
+		Value: fmt.Sprintf(
+			"field %s has unknown tag option %q, valid options are %s",
+			field, option, strings.Join(tagOptions, ", "),
+		),
+	}
+}
+
+// misspelledOption reports whether name, used as an env name after the first one of a tag, is
+// more likely a misspelled option without a value: it is lowercase and at most two edits away
+// from required, json, file or secret. Env names are conventionally uppercase, so this never rejects
+// names like HTTP_PROXY.
+func misspelledOption(name string) bool {
+	if name != strings.ToLower(name) {
+		return false
+	}
+	for _, option := range []string{"required", "json", "file", "secret"} {
+		if editDistance(name, option) <= 2 {
+			return true
+		}
+	}
+	return false
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a string, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
 }
@@ -2,7 +2,12 @@
 package env
 
 import (
+	"encoding/json"
+	"errors"
+	"io/fs"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -351,3 +356,1048 @@ func TestRequiredFalseFeature(t *testing.T) {
 		}
 	})
 }
+
+func TestJSONRawMessage(t *testing.T) {
+	type pluginConfig struct {
+		Name    string `json:"name"`
+		Options struct {
+			Retries int      `json:"retries"`
+			Tags    []string `json:"tags"`
+		} `json:"options"`
+	}
+	type config struct {
+		Plugin json.RawMessage `env:"PLUGIN_CONFIG,json"`
+	}
+
+	t.Run("round-trips a nested object", func(t *testing.T) {
+		raw := `{"name":"plugin","options":{"retries":3,"tags":["a","b"]}}`
+		es := envSet{"PLUGIN_CONFIG": raw}
+
+		cfg := &config{}
+		if err := unmarshal(es, cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if string(cfg.Plugin) != raw {
+			t.Errorf("Plugin = %s, want %s", cfg.Plugin, raw)
+		}
+
+		var plugin pluginConfig
+		if err := json.Unmarshal(cfg.Plugin, &plugin); err != nil {
+			t.Fatalf("Failed to decode plugin config: %v", err)
+		}
+		if plugin.Name != "plugin" || plugin.Options.Retries != 3 || len(plugin.Options.Tags) != 2 {
+			t.Errorf("Decoded plugin config = %+v", plugin)
+		}
+
+		marshaled, err := Marshal(cfg)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if marshaled["PLUGIN_CONFIG"] != raw {
+			t.Errorf("Marshal() PLUGIN_CONFIG = %s, want %s", marshaled["PLUGIN_CONFIG"], raw)
+		}
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		es := envSet{"PLUGIN_CONFIG": `{"name":`}
+
+		err := unmarshal(es, &config{})
+		if _, ok := err.(ErrInvalidValue); !ok {
+			t.Errorf("Expected ErrInvalidValue but got %v", err)
+		}
+	})
+}
+
+func TestMap(t *testing.T) {
+	type config struct {
+		Labels  map[string]string `env:"LABELS"`
+		Custom  map[string]string `env:"CUSTOM,sep=;,kvsep=:"`
+		Weights map[string]int    `env:"WEIGHTS"`
+	}
+
+	t.Run("parses key/value pairs", func(t *testing.T) {
+		es := envSet{
+			"LABELS":  "env=prod,team=core",
+			"CUSTOM":  "env:prod;url:http://example.com?a=b",
+			"WEIGHTS": "a=1,b=2",
+		}
+
+		cfg := &config{}
+		if err := unmarshal(es, cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if !reflect.DeepEqual(cfg.Labels, map[string]string{"env": "prod", "team": "core"}) {
+			t.Errorf("Labels = %v", cfg.Labels)
+		}
+		if !reflect.DeepEqual(cfg.Custom, map[string]string{"env": "prod", "url": "http://example.com?a=b"}) {
+			t.Errorf("Custom = %v", cfg.Custom)
+		}
+		if !reflect.DeepEqual(cfg.Weights, map[string]int{"a": 1, "b": 2}) {
+			t.Errorf("Weights = %v", cfg.Weights)
+		}
+
+		marshaled, err := Marshal(cfg)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if marshaled["LABELS"] != "env=prod,team=core" {
+			t.Errorf("Marshal() LABELS = %s", marshaled["LABELS"])
+		}
+		if marshaled["CUSTOM"] != "env:prod;url:http://example.com?a=b" {
+			t.Errorf("Marshal() CUSTOM = %s", marshaled["CUSTOM"])
+		}
+	})
+
+	t.Run("malformed pair", func(t *testing.T) {
+		es := envSet{"LABELS": "env=prod,team"}
+
+		err := unmarshal(es, &config{})
+		want := ErrInvalidValue{Value: `field Labels has malformed pair "team"`}
+		if err != want {
+			t.Errorf("Expected %v but got %v", want, err)
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		es := envSet{"WEIGHTS": "a=one"}
+
+		if err := unmarshal(es, &config{}); err == nil {
+			t.Error("Expected an error but got nil")
+		}
+	})
+}
+
+type BaseConfig struct {
+	ServiceName string `env:"SERVICE_NAME,required"`
+	LogLevel    string `env:"LOG_LEVEL,default=info"`
+}
+
+type tracingConfig struct {
+	Endpoint string `env:"TRACING_ENDPOINT"`
+}
+
+type RetryConfig struct {
+	Attempts int `env:"RETRY_ATTEMPTS,default=3"`
+}
+
+func TestEmbeddedStruct(t *testing.T) {
+	type config struct {
+		BaseConfig
+		tracingConfig
+		*RetryConfig
+		Port int `env:"PORT"`
+	}
+
+	t.Run("promotes embedded fields", func(t *testing.T) {
+		es := envSet{
+			"SERVICE_NAME":     "api",
+			"TRACING_ENDPOINT": "http://collector:4318",
+			"PORT":             "8080",
+		}
+
+		cfg := &config{}
+		if err := unmarshal(es, cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if cfg.ServiceName != "api" {
+			t.Errorf("ServiceName = %v, want api", cfg.ServiceName)
+		}
+		if cfg.LogLevel != "info" {
+			t.Errorf("LogLevel = %v, want info", cfg.LogLevel)
+		}
+		if cfg.Endpoint != "http://collector:4318" {
+			t.Errorf("Endpoint = %v, want http://collector:4318", cfg.Endpoint)
+		}
+		if cfg.RetryConfig == nil || cfg.Attempts != 3 {
+			t.Errorf("RetryConfig = %+v, want Attempts 3", cfg.RetryConfig)
+		}
+		if cfg.Port != 8080 {
+			t.Errorf("Port = %v, want 8080", cfg.Port)
+		}
+	})
+
+	t.Run("missing required embedded field", func(t *testing.T) {
+		err := unmarshal(envSet{"PORT": "8080"}, &config{})
+		want := &ErrMissingRequiredValue{Value: "SERVICE_NAME"}
+		if !reflect.DeepEqual(err, want) {
+			t.Errorf("Expected %v but got %v", want, err)
+		}
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		want := []KeyInfo{
+			{Field: "ServiceName", Keys: []string{"SERVICE_NAME"}, Required: true},
+			{Field: "LogLevel", Keys: []string{"LOG_LEVEL"}, Default: "info"},
+			{Field: "Endpoint", Keys: []string{"TRACING_ENDPOINT"}},
+			{Field: "Attempts", Keys: []string{"RETRY_ATTEMPTS"}, Default: "3"},
+			{Field: "Port", Keys: []string{"PORT"}},
+		}
+		got, err := Keys(config{})
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Keys() = %+v, want %+v", got, want)
+		}
+	})
+}
+
+func TestPointerFields(t *testing.T) {
+	type config struct {
+		Int         *int    `env:"PTR_INT"`
+		Bool        *bool   `env:"PTR_BOOL"`
+		String      *string `env:"PTR_STRING"`
+		WithDefault *int    `env:"PTR_DEFAULT,default=3"`
+	}
+
+	t.Run("present values are allocated", func(t *testing.T) {
+		es := envSet{"PTR_INT": "0", "PTR_BOOL": "false", "PTR_STRING": ""}
+
+		cfg := &config{}
+		if err := unmarshal(es, cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if cfg.Int == nil || *cfg.Int != 0 {
+			t.Errorf("Int = %v, want pointer to 0", cfg.Int)
+		}
+		if cfg.Bool == nil || *cfg.Bool {
+			t.Errorf("Bool = %v, want pointer to false", cfg.Bool)
+		}
+		if cfg.String == nil || *cfg.String != "" {
+			t.Errorf("String = %v, want pointer to empty string", cfg.String)
+		}
+		if cfg.WithDefault == nil || *cfg.WithDefault != 3 {
+			t.Errorf("WithDefault = %v, want pointer to 3", cfg.WithDefault)
+		}
+	})
+
+	t.Run("absent values stay nil", func(t *testing.T) {
+		cfg := &config{}
+		if err := unmarshal(envSet{}, cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if cfg.Int != nil {
+			t.Errorf("Int = %v, want nil", *cfg.Int)
+		}
+		if cfg.Bool != nil {
+			t.Errorf("Bool = %v, want nil", *cfg.Bool)
+		}
+		if cfg.String != nil {
+			t.Errorf("String = %v, want nil", *cfg.String)
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		cfg := &config{}
+		if err := unmarshal(envSet{"PTR_INT": "one"}, cfg); err == nil {
+			t.Error("Expected an error but got nil")
+		}
+		if cfg.Int != nil {
+			t.Errorf("Int = %v, want nil", *cfg.Int)
+		}
+	})
+
+	t.Run("marshal skips nil pointers", func(t *testing.T) {
+		value := 8080
+		es, err := Marshal(&config{Int: &value})
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		want := envSet{"PTR_INT": "8080"}
+		if !reflect.DeepEqual(es, want) {
+			t.Errorf("Marshal() = %v, want %v", es, want)
+		}
+	})
+}
+
+func TestUnknownTagOption(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  interface{}
+		want string
+	}{
+		{
+			name: "misspelled option",
+			cfg: &struct {
+				Foo string `env:"FOO,requird"`
+			}{},
+			want: `field Foo has unknown tag option "requird"`,
+		},
+		{
+			name: "misspelled option with a value",
+			cfg: &struct {
+				Foo string `env:"FOO,defualt=x"`
+			}{},
+			want: `field Foo has unknown tag option "defualt"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := unmarshal(envSet{"FOO": "foo"}, tt.cfg)
+			unsupported, ok := err.(ErrUnsupportedField)
+			if !ok {
+				t.Fatalf("Expected ErrUnsupportedField but got %v", err)
+			}
+			if !strings.HasPrefix(unsupported.Value, tt.want) {
+				t.Errorf("Value = %q, want prefix %q", unsupported.Value, tt.want)
+			}
+			if !strings.Contains(unsupported.Value, "required, default, alias") {
+				t.Errorf("Value = %q, want the valid options listed", unsupported.Value)
+			}
+
+			if _, err := Keys(tt.cfg); err == nil {
+				t.Error("Keys() expected an error")
+			}
+			if _, err := Marshal(tt.cfg); err == nil {
+				t.Error("Marshal() expected an error")
+			}
+		})
+	}
+
+	t.Run("lowercase env names", func(t *testing.T) {
+		cfg := &struct {
+			Proxy string `env:"HTTP_PROXY,http_proxy"`
+			Files string `env:"files"`
+		}{}
+		es := envSet{"http_proxy": "http://proxy:3128", "files": "a.txt"}
+		if err := unmarshal(es, cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if cfg.Proxy != "http://proxy:3128" || cfg.Files != "a.txt" {
+			t.Errorf("cfg = %+v", cfg)
+		}
+	})
+}
+
+func TestParseWithPrefix(t *testing.T) {
+	type config struct {
+		Host     string `env:"HOST"`
+		Port     int    `env:"PORT,default=8080"`
+		URL      string `env:"PRIMARY_URL,FALLBACK_URL"`
+		Required string `env:"API_KEY,required"`
+		Nested   struct {
+			Name string `env:"DB_NAME"`
+		}
+	}
+
+	t.Run("prefixes every name", func(t *testing.T) {
+		os.Clearenv()
+		os.Setenv("MYAPP_HOST", "localhost")
+		os.Setenv("HOST", "unprefixed")
+		os.Setenv("MYAPP_FALLBACK_URL", "http://fallback")
+		os.Setenv("MYAPP_API_KEY", "key")
+		os.Setenv("MYAPP_DB_NAME", "db")
+
+		cfg := &config{}
+		if err := ParseWithPrefix("MYAPP_", cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if cfg.Host != "localhost" {
+			t.Errorf("Host = %v, want localhost", cfg.Host)
+		}
+		if cfg.Port != 8080 {
+			t.Errorf("Port = %v, want 8080", cfg.Port)
+		}
+		if cfg.URL != "http://fallback" {
+			t.Errorf("URL = %v, want http://fallback", cfg.URL)
+		}
+		if cfg.Nested.Name != "db" {
+			t.Errorf("Nested.Name = %v, want db", cfg.Nested.Name)
+		}
+	})
+
+	t.Run("missing required value", func(t *testing.T) {
+		os.Clearenv()
+		os.Setenv("API_KEY", "unprefixed")
+
+		err := ParseWithPrefix("MYAPP_", &config{})
+		missing, ok := err.(*ErrMissingRequiredValue)
+		if !ok {
+			t.Fatalf("Expected ErrMissingRequiredValue but got %v", err)
+		}
+		if missing.Value != "MYAPP_API_KEY" {
+			t.Errorf("Missing value = %v, want MYAPP_API_KEY", missing.Value)
+		}
+	})
+}
+
+func TestTagWithoutName(t *testing.T) {
+	type config struct {
+		Default  string `env:"default=x"`
+		Required string `env:"required"`
+	}
+
+	os.Clearenv()
+	cfg := &struct {
+		Default string `env:"default=x"`
+		Min     int    `env:"default=5,min=1"`
+	}{}
+	if _, err := Unmarshal(cfg); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if cfg.Default != "x" || cfg.Min != 5 {
+		t.Errorf("got %+v, want the defaults", cfg)
+	}
+
+	err := Parse(&config{})
+	var missing *ErrMissingRequiredValue
+	if !errors.As(err, &missing) || missing.Value != "Required" {
+		t.Errorf("Expected ErrMissingRequiredValue naming the field, but got: %v", err)
+	}
+}
+
+func TestParseBool(t *testing.T) {
+	for _, value := range []string{"1", "t", "T", "true", "TRUE", "True", "yes", "YES", "on", "On", "enabled", "ENABLED"} {
+		got, err := parseBool(value)
+		if err != nil || !got {
+			t.Errorf("parseBool(%q) = %v, %v, want true", value, got, err)
+		}
+	}
+	for _, value := range []string{"0", "f", "F", "false", "FALSE", "no", "No", "off", "OFF", "disabled", "Disabled"} {
+		got, err := parseBool(value)
+		if err != nil || got {
+			t.Errorf("parseBool(%q) = %v, %v, want false", value, got, err)
+		}
+	}
+	for _, value := range []string{"", " yes", "y", "2", "enable", "not-a-bool"} {
+		if _, err := parseBool(value); err == nil {
+			t.Errorf("parseBool(%q) should fail", value)
+		}
+	}
+
+	os.Clearenv()
+	os.Setenv("TEST_BOOL", "Enabled")
+	cfg := &struct {
+		Bool bool            `env:"TEST_BOOL"`
+		Map  map[string]bool `env:"TEST_BOOL_MAP"`
+	}{}
+	os.Setenv("TEST_BOOL_MAP", "a=on,b=off")
+	if err := Parse(cfg); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if !cfg.Bool {
+		t.Errorf("Bool = %v, want true", cfg.Bool)
+	}
+	if !cfg.Map["a"] || cfg.Map["b"] {
+		t.Errorf("Map = %v, want map[a:true b:false]", cfg.Map)
+	}
+}
+
+func TestParseWithExpand(t *testing.T) {
+	type config struct {
+		URL      string `env:"URL"`
+		Fallback string `env:"FALLBACK"`
+		Default  string `env:"DEFAULT_URL,default=http://${HOST}/"`
+		Password string `env:"PASSWORD"`
+		Port     int    `env:"APP_PORT"`
+	}
+
+	t.Run("expands references", func(t *testing.T) {
+		os.Clearenv()
+		os.Setenv("HOST", "example.com")
+		os.Setenv("PORT", "8443")
+		os.Setenv("EMPTY", "")
+		os.Setenv("URL", "https://${HOST}:$PORT/")
+		os.Setenv("FALLBACK", "${EMPTY:-a}-${MISSING:-b}-${HOST:-c}")
+		os.Setenv("PASSWORD", "pa$$word")
+		os.Setenv("APP_PORT", "${PORT}")
+
+		cfg := &config{}
+		if err := ParseWithExpand(cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if cfg.URL != "https://example.com:8443/" {
+			t.Errorf("URL = %v, want https://example.com:8443/", cfg.URL)
+		}
+		if cfg.Fallback != "a-b-example.com" {
+			t.Errorf("Fallback = %v, want a-b-example.com", cfg.Fallback)
+		}
+		if cfg.Default != "http://example.com/" {
+			t.Errorf("Default = %v, want http://example.com/", cfg.Default)
+		}
+		if cfg.Password != "pa$word" {
+			t.Errorf("Password = %v, want pa$word", cfg.Password)
+		}
+		if cfg.Port != 8443 {
+			t.Errorf("Port = %v, want 8443", cfg.Port)
+		}
+	})
+
+	t.Run("unset references expand to empty", func(t *testing.T) {
+		os.Clearenv()
+		os.Setenv("URL", "https://${HOST}/")
+
+		cfg := &config{}
+		if err := ParseWithExpand(cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if cfg.URL != "https:///" {
+			t.Errorf("URL = %v, want https:///", cfg.URL)
+		}
+	})
+
+	t.Run("strict fails on unset references", func(t *testing.T) {
+		os.Clearenv()
+		os.Setenv("URL", "https://${HOST}:${PORT:-443}/")
+
+		err := Parse(&config{}, WithStrictExpand())
+		unresolved, ok := err.(ErrUnresolvedReference)
+		if !ok {
+			t.Fatalf("Expected ErrUnresolvedReference but got %v", err)
+		}
+		if unresolved.Value != "URL: HOST" {
+			t.Errorf("Unresolved value = %v, want URL: HOST", unresolved.Value)
+		}
+	})
+
+	t.Run("secrets are not expanded", func(t *testing.T) {
+		os.Clearenv()
+		secretFile := t.TempDir() + "/password"
+		if err := os.WriteFile(secretFile, []byte("p@$$w0rd\n"), 0o600); err != nil {
+			t.Fatalf("Failed to write secret file: %v", err)
+		}
+		os.Setenv("HOST", "example.com")
+		os.Setenv("DB_PASSWORD_FILE", secretFile)
+		os.Setenv("API_KEY", "k$HOST")
+
+		cfg := &struct {
+			DBPassword string `env:"DB_PASSWORD,file"`
+			APIKey     string `env:"API_KEY,secret"`
+		}{}
+		if err := ParseWithExpand(cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if cfg.DBPassword != "p@$$w0rd" {
+			t.Errorf("DBPassword = %v, want p@$$w0rd", cfg.DBPassword)
+		}
+		if cfg.APIKey != "k$HOST" {
+			t.Errorf("APIKey = %v, want k$HOST", cfg.APIKey)
+		}
+	})
+
+	t.Run("not expanded by default", func(t *testing.T) {
+		os.Clearenv()
+		os.Setenv("HOST", "example.com")
+		os.Setenv("URL", "https://${HOST}/")
+
+		cfg := &config{}
+		if err := Parse(cfg); err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if cfg.URL != "https://${HOST}/" {
+			t.Errorf("URL = %v, want https://${HOST}/", cfg.URL)
+		}
+	})
+}
+
+func TestFileOption(t *testing.T) {
+	type config struct {
+		Password string `env:"DB_PASSWORD,file,required"`
+		Token    string `env:"TOKEN,file,default=none"`
+	}
+
+	dir := t.TempDir()
+	secretFile := dir + "/password"
+	if err := os.WriteFile(secretFile, []byte("s3cret\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write secret file: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		es           envSet
+		wantPassword string
+		wantToken    string
+		wantErr      error
+	}{
+		{
+			name:         "reads the file when the env var is absent",
+			es:           envSet{"DB_PASSWORD_FILE": secretFile},
+			wantPassword: "s3cret",
+			wantToken:    "none",
+		},
+		{
+			name:         "prefers the env var",
+			es:           envSet{"DB_PASSWORD": "direct", "DB_PASSWORD_FILE": secretFile},
+			wantPassword: "direct",
+			wantToken:    "none",
+		},
+		{
+			name:         "reads the file when the env var is empty",
+			es:           envSet{"DB_PASSWORD": "", "DB_PASSWORD_FILE": secretFile, "TOKEN_FILE": secretFile},
+			wantPassword: "s3cret",
+			wantToken:    "s3cret",
+		},
+		{
+			name:    "missing env var and file var",
+			es:      envSet{},
+			wantErr: &ErrMissingRequiredValue{Value: "DB_PASSWORD"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config{}
+			err := unmarshal(tt.es, cfg)
+			if tt.wantErr != nil {
+				if !reflect.DeepEqual(err, tt.wantErr) {
+					t.Errorf("Expected %v but got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if cfg.Password != tt.wantPassword {
+				t.Errorf("Password = %v, want %v", cfg.Password, tt.wantPassword)
+			}
+			if cfg.Token != tt.wantToken {
+				t.Errorf("Token = %v, want %v", cfg.Token, tt.wantToken)
+			}
+		})
+	}
+
+	t.Run("unreadable file", func(t *testing.T) {
+		es := envSet{"DB_PASSWORD_FILE": secretFile, "TOKEN_FILE": dir + "/missing"}
+		err := unmarshal(es, &config{})
+		var readErr ErrReadFile
+		if !errors.As(err, &readErr) {
+			t.Fatalf("Expected ErrReadFile but got %v", err)
+		}
+		if readErr.Value != "TOKEN_FILE" {
+			t.Errorf("ErrReadFile value = %v, want TOKEN_FILE", readErr.Value)
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected the error to wrap fs.ErrNotExist, got %v", err)
+		}
+	})
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(t *testing.T, content string) string {
+		t.Helper()
+		path := dir + "/" + strings.ReplaceAll(t.Name(), "/", "_") + ".env"
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("Failed to write env file: %v", err)
+		}
+		return path
+	}
+
+	t.Run("parses lines", func(t *testing.T) {
+		path := write(t, `# local settings
+HOST=localhost
+export PORT=8080
+
+EMPTY=
+SPACED = value with spaces   # trailing comment
+HASH=abc#def
+DOUBLE="line1\nline2 \"quoted\""  # comment
+SINGLE='literal \n $VALUE'
+URL=postgres://user:p=ss@db:5432/app
+HOST=overridden
+`)
+		got, err := LoadFile(path)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		want := map[string]string{
+			"HOST":   "overridden",
+			"PORT":   "8080",
+			"EMPTY":  "",
+			"SPACED": "value with spaces",
+			"HASH":   "abc#def",
+			"DOUBLE": "line1\nline2 \"quoted\"",
+			"SINGLE": `literal \n $VALUE`,
+			"URL":    "postgres://user:p=ss@db:5432/app",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("LoadFile() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("invalid lines", func(t *testing.T) {
+		for _, content := range []string{"NOVALUE", "=value", `KEY="unterminated`, `KEY='a' b`, "TWO WORDS=x"} {
+			_, err := LoadFile(write(t, "OK=1\n"+content+"\n"))
+			invalid, ok := err.(ErrInvalidEnvSet)
+			if !ok {
+				t.Errorf("LoadFile(%q) error = %v, want ErrInvalidEnvSet", content, err)
+				continue
+			}
+			if !strings.HasSuffix(invalid.Value, " line 2") {
+				t.Errorf("LoadFile(%q) error = %v, want the line number", content, err)
+			}
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadFile(dir + "/missing.env")
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected fs.ErrNotExist but got %v", err)
+		}
+	})
+}
+
+func TestParseWithFile(t *testing.T) {
+	type config struct {
+		Host string `env:"HOST"`
+		Port int    `env:"PORT,default=80"`
+		Key  string `env:"API_KEY,required"`
+	}
+
+	path := t.TempDir() + "/.env"
+	if err := os.WriteFile(path, []byte("HOST=from-file\nPORT=8080\nAPI_KEY=file-key\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write env file: %v", err)
+	}
+
+	os.Clearenv()
+	os.Setenv("HOST", "from-env")
+
+	cfg := &config{}
+	if err := ParseWithFile(path, cfg); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	want := config{Host: "from-env", Port: 8080, Key: "file-key"}
+	if *cfg != want {
+		t.Errorf("ParseWithFile() = %+v, want %+v", *cfg, want)
+	}
+	if _, ok := os.LookupEnv("API_KEY"); ok {
+		t.Errorf("ParseWithFile() must not modify the environment")
+	}
+}
+
+func TestAliasAndCaseInsensitive(t *testing.T) {
+	type config struct {
+		URL  string `env:"SERVICE_URL,alias=LEGACY_URL,alias=OLD_URL"`
+		Port int    `env:"PORT,alias=LEGACY_PORT,default=8080"`
+	}
+
+	tests := []struct {
+		name     string
+		envs     map[string]string
+		opts     []Option
+		wantURL  string
+		wantPort int
+	}{
+		{
+			name:     "canonical name wins over aliases",
+			envs:     map[string]string{"SERVICE_URL": "canonical", "LEGACY_URL": "legacy"},
+			wantURL:  "canonical",
+			wantPort: 8080,
+		},
+		{
+			name:     "aliases in declared order",
+			envs:     map[string]string{"OLD_URL": "old", "LEGACY_URL": "legacy", "LEGACY_PORT": "9090"},
+			wantURL:  "legacy",
+			wantPort: 9090,
+		},
+		{
+			name:     "case sensitive by default",
+			envs:     map[string]string{"service_url": "lower"},
+			wantURL:  "",
+			wantPort: 8080,
+		},
+		{
+			name:     "case insensitive",
+			envs:     map[string]string{"service_url": "lower", "Port": "9090"},
+			opts:     []Option{WithCaseInsensitive()},
+			wantURL:  "lower",
+			wantPort: 9090,
+		},
+		{
+			name:     "case insensitive picks the first sorted name",
+			envs:     map[string]string{"port": "7070", "pORT": "6060", "Port": "9090"},
+			opts:     []Option{WithCaseInsensitive()},
+			wantPort: 9090,
+		},
+		{
+			name:     "case insensitive alias with prefix",
+			envs:     map[string]string{"myapp_legacy_url": "legacy"},
+			opts:     []Option{WithCaseInsensitive(), WithPrefix("MYAPP_")},
+			wantURL:  "legacy",
+			wantPort: 8080,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Clearenv()
+			for k, v := range tt.envs {
+				os.Setenv(k, v)
+			}
+
+			cfg := &config{}
+			if err := Parse(cfg, tt.opts...); err != nil {
+				t.Fatalf("Expected no error, but got: %v", err)
+			}
+			if cfg.URL != tt.wantURL {
+				t.Errorf("URL = %v, want %v", cfg.URL, tt.wantURL)
+			}
+			if cfg.Port != tt.wantPort {
+				t.Errorf("Port = %v, want %v", cfg.Port, tt.wantPort)
+			}
+		})
+	}
+}
+
+type validatedConfig struct {
+	Mode string `env:"MODE"`
+	Port int    `env:"PORT"`
+}
+
+func (c *validatedConfig) Validate() error {
+	if c.Mode == "prod" && c.Port == 8080 {
+		return ErrInvalidValue{Value: "prod must not use the default port"}
+	}
+	return nil
+}
+
+func TestValidation(t *testing.T) {
+	type config struct {
+		Port    int           `env:"PORT,default=8080,min=1,max=65535"`
+		Mode    string        `env:"MODE,oneof=dev|prod"`
+		Ratio   *float64      `env:"RATIO,min=0,max=1"`
+		Timeout time.Duration `env:"TIMEOUT,default=5s,min=1s,max=1m"`
+	}
+
+	tests := []struct {
+		name    string
+		es      envSet
+		wantErr error
+	}{
+		{
+			name: "valid values",
+			es:   envSet{"PORT": "443", "MODE": "prod", "RATIO": "0.5", "TIMEOUT": "30s"},
+		},
+		{
+			name: "defaults and unset fields",
+			es:   envSet{},
+		},
+		{
+			name:    "below min",
+			es:      envSet{"PORT": "0"},
+			wantErr: ErrInvalidValue{Value: "PORT must be >= 1"},
+		},
+		{
+			name:    "above max",
+			es:      envSet{"PORT": "70000"},
+			wantErr: ErrInvalidValue{Value: "PORT must be <= 65535"},
+		},
+		{
+			name:    "pointer above max",
+			es:      envSet{"RATIO": "1.5"},
+			wantErr: ErrInvalidValue{Value: "RATIO must be <= 1"},
+		},
+		{
+			name:    "duration below min",
+			es:      envSet{"TIMEOUT": "500ms"},
+			wantErr: ErrInvalidValue{Value: "TIMEOUT must be >= 1s"},
+		},
+		{
+			name:    "not one of",
+			es:      envSet{"MODE": "staging"},
+			wantErr: ErrInvalidValue{Value: "MODE must be one of dev|prod"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config{}
+			err := unmarshal(tt.es, cfg)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Expected %v but got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("min on a string field", func(t *testing.T) {
+		cfg := &struct {
+			Name string `env:"NAME,min=1"`
+		}{}
+		err := unmarshal(envSet{"NAME": "app"}, cfg)
+		if _, ok := err.(ErrUnsupportedField); !ok {
+			t.Errorf("Expected ErrUnsupportedField but got %v", err)
+		}
+	})
+
+	t.Run("validator", func(t *testing.T) {
+		cfg := &validatedConfig{}
+		if err := unmarshal(envSet{"MODE": "prod", "PORT": "443"}, cfg); err != nil {
+			t.Errorf("Expected no error, but got: %v", err)
+		}
+		want := ErrInvalidValue{Value: "prod must not use the default port"}
+		if err := unmarshal(envSet{"MODE": "prod", "PORT": "8080"}, cfg); !reflect.DeepEqual(err, want) {
+			t.Errorf("Expected %v but got %v", want, err)
+		}
+	})
+}
+
+func TestKeys(t *testing.T) {
+	type config struct {
+		Host     string `env:"HOST,required"`
+		Port     int    `env:"PORT,required,default=8080"`
+		URL      string `env:"SERVICE_URL,alias=LEGACY_URL"`
+		Password string `env:"DB_PASSWORD,file"`
+		Database struct {
+			Name string `env:"DB_NAME,DATABASE_NAME"`
+		}
+		Ignored string
+	}
+
+	want := []KeyInfo{
+		{Field: "Host", Keys: []string{"HOST"}, Required: true},
+		{Field: "Port", Keys: []string{"PORT"}, Default: "8080"},
+		{Field: "URL", Keys: []string{"SERVICE_URL"}, Aliases: []string{"LEGACY_URL"}},
+		{Field: "Password", Keys: []string{"DB_PASSWORD"}, File: true},
+		{Field: "Database.Name", Keys: []string{"DB_NAME", "DATABASE_NAME"}},
+	}
+
+	for _, cfg := range []interface{}{config{}, &config{}} {
+		got, err := Keys(cfg)
+		if err != nil {
+			t.Fatalf("Expected no error, but got: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Keys(%T) = %+v, want %+v", cfg, got, want)
+		}
+	}
+
+	for _, cfg := range []interface{}{nil, "config", (*string)(nil)} {
+		if _, err := Keys(cfg); err == nil {
+			t.Errorf("Keys(%T) expected an error", cfg)
+		}
+	}
+}
+
+type logLevel int
+
+func TestRegisterDecoder(t *testing.T) {
+	levelType := reflect.TypeOf(logLevel(0))
+	RegisterDecoder(levelType, func(value string) (interface{}, error) {
+		switch value {
+		case "debug":
+			return logLevel(0), nil
+		case "info":
+			return logLevel(1), nil
+		case "error":
+			return logLevel(2), nil
+		}
+		return nil, errors.New("unknown log level " + value)
+	})
+	defer RegisterDecoder(levelType, nil)
+
+	type config struct {
+		Level    logLevel            `env:"LOG_LEVEL"`
+		LevelPtr *logLevel           `env:"LOG_LEVEL_PTR"`
+		Levels   map[string]logLevel `env:"LOG_LEVELS"`
+	}
+
+	os.Clearenv()
+	os.Setenv("LOG_LEVEL", "error")
+	os.Setenv("LOG_LEVEL_PTR", "info")
+	os.Setenv("LOG_LEVELS", "http=debug,db=error")
+	cfg := &config{}
+	if err := Parse(cfg); err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if cfg.Level != 2 {
+		t.Errorf("Level = %v, want 2", cfg.Level)
+	}
+	if cfg.LevelPtr == nil || *cfg.LevelPtr != 1 {
+		t.Errorf("LevelPtr = %v, want 1", cfg.LevelPtr)
+	}
+	if want := map[string]logLevel{"http": 0, "db": 2}; !reflect.DeepEqual(cfg.Levels, want) {
+		t.Errorf("Levels = %v, want %v", cfg.Levels, want)
+	}
+
+	os.Setenv("LOG_LEVEL", "verbose")
+	if err := Parse(&config{}); err == nil || err.Error() != "unknown log level verbose" {
+		t.Errorf("Expected the decoder error, but got: %v", err)
+	}
+
+	RegisterDecoder(levelType, func(value string) (interface{}, error) {
+		return value, nil
+	})
+	os.Setenv("LOG_LEVEL", "info")
+	var invalidValue ErrInvalidValue
+	if err := Parse(&config{}); !errors.As(err, &invalidValue) {
+		t.Errorf("Expected ErrInvalidValue for a decoded value of the wrong type, but got: %v", err)
+	}
+
+	RegisterDecoder(levelType, nil)
+	os.Clearenv()
+	os.Setenv("LOG_LEVEL", "3")
+	cfg = &config{}
+	if err := Parse(cfg); err != nil || cfg.Level != 3 {
+		t.Errorf("Expected the built-in int parsing once the decoder is removed, but got: %v, %v", cfg.Level, err)
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	type Database struct {
+		Host     string `env:"DB_HOST"`
+		Password string `env:"DB_PASSWORD,secret"`
+	}
+	type config struct {
+		Port     int               `env:"PORT"`
+		Token    *string           `env:"TOKEN,secret"`
+		Timeout  *time.Duration    `env:"TIMEOUT"`
+		Labels   map[string]string `env:"LABELS"`
+		Database Database
+		Creds    struct {
+			Token string `env:"CREDS_TOKEN"`
+		} `env:"CREDS,secret"`
+		Unnamed string `env:"secret"`
+		Broken  string `env:"BROKEN,requird"`
+		Ignored string
+	}
+
+	token := "s3cr3t"
+	cfg := config{
+		Port:     8080,
+		Token:    &token,
+		Labels:   map[string]string{"team": "core", "env": "prod"},
+		Database: Database{Host: "db.internal", Password: "hunter2"},
+		Broken:   "hunter3",
+		Unnamed:  "hunter4",
+	}
+	cfg.Creds.Token = "hunter5"
+	want := strings.Join([]string{
+		"PORT=8080",
+		"TOKEN=****",
+		"TIMEOUT=<nil>",
+		"LABELS=env=prod,team=core",
+		"DB_HOST=db.internal",
+		"DB_PASSWORD=****",
+		"CREDS_TOKEN=****",
+		"CREDS=****",
+		"Unnamed=****",
+		"Broken=****",
+	}, "\n")
+
+	for _, v := range []interface{}{cfg, &cfg} {
+		got := Redacted(v)
+		if got != want {
+			t.Errorf("Redacted(%T) = %q, want %q", v, got, want)
+		}
+		for _, secret := range []string{"s3cr3t", "hunter2", "hunter3", "hunter4", "hunter5"} {
+			if strings.Contains(got, secret) {
+				t.Errorf("Redacted(%T) leaks %q", v, secret)
+			}
+		}
+	}
+
+	for _, v := range []interface{}{nil, "config", (*config)(nil)} {
+		if got := Redacted(v); got != "" {
+			t.Errorf("Redacted(%T) = %q, want empty", v, got)
+		}
+	}
+
+	keys, err := Keys(Database{})
+	if err != nil {
+		t.Fatalf("Expected no error, but got: %v", err)
+	}
+	if keys[0].Secret || !keys[1].Secret {
+		t.Errorf("Keys(Database) = %+v, want only DB_PASSWORD secret", keys)
+	}
+}
@@ -44,3 +44,26 @@ type ErrInvalidEnvSet struct {
 func (e ErrInvalidEnvSet) Error() string {
 	return fmt.Sprintf("items in environ must have format key=value [%s]", e.Value)
 }
+
+// ErrUnresolvedReference returned with WithStrictExpand when a value references an env var that is not set
+type ErrUnresolvedReference struct {
+	Value string
+}
+
+func (e ErrUnresolvedReference) Error() string {
+	return fmt.Sprintf("value for this env references an unset env [%s]", e.Value)
+}
+
+// ErrReadFile returned when the file named by <NAME>_FILE of a field with the file option can't be read
+type ErrReadFile struct {
+	Value string
+	Err   error
+}
+
+func (e ErrReadFile) Error() string {
+	return fmt.Sprintf("file for this env can't be read [%s]: %v", e.Value, e.Err)
+}
+
+func (e ErrReadFile) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,34 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// expand substitutes the $VAR, ${VAR} and ${VAR:-default} references in value, the value of key,
+// with the env vars of es. References are looked up without the prefix of cfg, and the values
+// they expand to are not expanded again.
+func expand(es envSet, value string, key string, cfg *Config) (string, error) {
+	var unresolved []string
+	expanded := os.Expand(value, func(name string) string {
+		if name == "$" {
+			return "$"
+		}
+		name, fallback, hasFallback := strings.Cut(name, ":-")
+		if v, ok := lookup(es, name, cfg); ok && (v != "" || !hasFallback) {
+			return v
+		}
+		if hasFallback {
+			return fallback
+		}
+		unresolved = append(unresolved, name)
+		return ""
+	})
+	if cfg.StrictExpand && len(unresolved) > 0 {
+		return "", ErrUnresolvedReference{
+			Value: fmt.Sprintf("%s: %s", key, strings.Join(unresolved, ", ")),
+		}
+	}
+	return expanded, nil
+}
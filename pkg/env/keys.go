@@ -0,0 +1,88 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// KeyInfo describes an env var consumed by a config struct field.
+type KeyInfo struct {
+	Field    string   // Field is the name of the struct field, nested fields are dot separated
+	Keys     []string // Keys are the env names, in lookup order
+	Aliases  []string // Aliases are the legacy env names tried after Keys
+	Required bool     // Required is true when the field has no default and must be set
+	Default  string   // Default is the value used when the env var is not set
+	File     bool     // File is true when the value can be read from the file named by <KEY>_FILE
+	Secret   bool     // Secret is true when the value is redacted by Redacted
+}
+
+// Keys returns the env vars read by the struct cfg, or by the struct cfg points to, in field
+// order and including nested and embedded structs. The environment is not read, so Keys can
+// be used to document a config struct or generate manifests.
+// Parameters:
+//
+//	cfg - interface{} [A struct or a pointer to a struct]
+//
+// Returns:
+//
+//   - []KeyInfo
+//   - error
+func Keys(cfg interface{}) ([]KeyInfo, error) {
+	t := reflect.TypeOf(cfg)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, ErrInvalidValue{
+			Value: fmt.Sprintf("%T", cfg),
+		}
+	}
+	return keys(t, "")
+}
+
+// keys returns the env vars of the struct type t, with prefix prepended to the field names.
+func keys(t reflect.Type, prefix string) ([]KeyInfo, error) {
+	var infos []KeyInfo
+	for i := 0; i < t.NumField(); i++ {
+		typeField := t.Field(i)
+		if typeField.Anonymous {
+			// the fields of embedded structs are promoted, so they keep the prefix of t
+			embedded := typeField.Type
+			if embedded.Kind() == reflect.Ptr && typeField.IsExported() {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				embeddedInfos, err := keys(embedded, prefix)
+				if err != nil {
+					return nil, err
+				}
+				infos = append(infos, embeddedInfos...)
+			}
+		} else if typeField.Type.Kind() == reflect.Struct && typeField.IsExported() {
+			nestedInfos, err := keys(typeField.Type, prefix+typeField.Name+".")
+			if err != nil {
+				return nil, err
+			}
+			infos = append(infos, nestedInfos...)
+		}
+
+		tag := typeField.Tag.Get("env")
+		if tag == "" {
+			continue
+		}
+		envTag, err := parseTag(fieldName(t, typeField), tag)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, KeyInfo{
+			Field:    prefix + typeField.Name,
+			Keys:     envTag.Keys,
+			Aliases:  envTag.Aliases,
+			Required: envTag.Required && envTag.Default == "",
+			Default:  envTag.Default,
+			File:     envTag.File,
+			Secret:   envTag.Secret,
+		})
+	}
+	return infos, nil
+}
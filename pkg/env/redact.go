@@ -0,0 +1,89 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// redactedValue replaces the values of the fields tagged secret.
+const redactedValue = "****"
+
+// Redacted returns the fields of the config struct cfg, or of the struct cfg points to, as
+// KEY=value lines in field order, with the values of fields tagged secret replaced by ****, e.g.
+// to log the effective configuration at startup without leaking credentials. Fields are named
+// by their first env name, or their field name when the tag has none, nested and embedded
+// structs included, and nil pointers are shown as <nil>. Every field of a struct tagged secret
+// is redacted, and an invalid tag is reported as a secret rather than risk printing a credential.
+// Parameters:
+//
+//	cfg - interface{} [A struct or a pointer to a struct]
+//
+// Returns:
+//
+//   - string [The dump, empty when cfg is not a struct]
+func Redacted(cfg interface{}) string {
+	rv := reflect.ValueOf(cfg)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+	var lines []string
+	redact(rv, false, &lines)
+	return strings.Join(lines, "\n")
+}
+
+// redact appends the KEY=value lines of the fields of the struct rv to lines. Every value is
+// redacted when secret is true, i.e. when rv is a field tagged secret itself.
+func redact(rv reflect.Value, secret bool, lines *[]string) {
+	t := rv.Type()
+	for i := 0; i < rv.NumField(); i++ {
+		typeField := t.Field(i)
+		valueField := rv.Field(i)
+		tag := typeField.Tag.Get("env")
+		envTag, err := parseTag(fieldName(t, typeField), tag)
+		// an invalid tag is redacted rather than risk printing a misspelled secret
+		fieldSecret := secret || envTag.Secret || err != nil
+
+		if typeField.Anonymous {
+			embedded := valueField
+			if embedded.Kind() == reflect.Ptr && !embedded.IsNil() {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				redact(embedded, fieldSecret, lines)
+			}
+		} else if valueField.Kind() == reflect.Struct {
+			redact(valueField, fieldSecret, lines)
+		}
+
+		if tag == "" {
+			continue
+		}
+		name, value := typeField.Name, redactedValue
+		if err == nil {
+			name = envTag.key(typeField.Name)
+		}
+		if !fieldSecret {
+			value = redactedFieldValue(valueField, envTag)
+		}
+		*lines = append(*lines, fmt.Sprintf("%s=%s", name, value))
+	}
+}
+
+// redactedFieldValue formats the value of a field tagged with envTag that is not a secret.
+func redactedFieldValue(v reflect.Value, envTag tag) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "<nil>"
+		}
+		v = v.Elem()
+	}
+	value, err := formatValue(v, envTag)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	return value
+}
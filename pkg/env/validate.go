@@ -0,0 +1,91 @@
+package env
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Validator is the interface implemented by config structs that validate themselves.
+// Validate is called once all the fields of the struct are set, and its error is
+// returned as is.
+type Validator interface {
+	Validate() error
+}
+
+// validate checks the value stored in f against the min, max and oneof options of the tag.
+// raw is the env value the field was parsed from and key the env name reported in errors.
+func validate(f reflect.Value, raw string, envTag tag, key string) error {
+	if len(envTag.OneOf) > 0 && !contains(envTag.OneOf, raw) {
+		return ErrInvalidValue{
+			Value: fmt.Sprintf("%s must be one of %s", key, strings.Join(envTag.OneOf, "|")),
+		}
+	}
+	if envTag.Min == "" && envTag.Max == "" {
+		return nil
+	}
+	for f.Kind() == reflect.Ptr {
+		f = f.Elem()
+	}
+	value, ok := number(f)
+	if !ok {
+		return ErrUnsupportedField{
+			Value: fmt.Sprintf("%s: min and max are only supported on numeric fields", key),
+		}
+	}
+	if envTag.Min != "" {
+		bound, err := parseBound(f.Type(), envTag.Min)
+		if err != nil {
+			return ErrInvalidValue{Value: fmt.Sprintf("%s has invalid min %q", key, envTag.Min)}
+		}
+		if value < bound {
+			return ErrInvalidValue{Value: fmt.Sprintf("%s must be >= %s", key, envTag.Min)}
+		}
+	}
+	if envTag.Max != "" {
+		bound, err := parseBound(f.Type(), envTag.Max)
+		if err != nil {
+			return ErrInvalidValue{Value: fmt.Sprintf("%s has invalid max %q", key, envTag.Max)}
+		}
+		if value > bound {
+			return ErrInvalidValue{Value: fmt.Sprintf("%s must be <= %s", key, envTag.Max)}
+		}
+	}
+	return nil
+}
+
+// number returns the value of a numeric field as a float64.
+func number(f reflect.Value) (float64, bool) {
+	switch f.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(f.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(f.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return f.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+// parseBound parses a min or max option, as a duration such as 1s for time.Duration fields.
+func parseBound(t reflect.Type, bound string) (float64, error) {
+	if t == durationType {
+		d, err := time.ParseDuration(bound)
+		return float64(d), err
+	}
+	return strconv.ParseFloat(bound, 64)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
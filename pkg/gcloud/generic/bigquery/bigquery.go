@@ -2,10 +2,17 @@ package bigquery
 
 //go:generate mockgen -source=interface.go -destination=mocks/mock-bigquery.go -package=mocks
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
 	"strings"
+	"time"
 
 	bq "cloud.google.com/go/bigquery"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
 )
 
@@ -48,6 +55,20 @@ func New[T any](opts ...Option) (IBigQuery[T], error) {
 // Returns:
 //   - error: An error if one occurs.
 func (b *bigQuery[T]) AppendMany(dataSet string, table string, data []T) error {
+	return b.AppendManyContext(b.cfg.Context, dataSet, table, data)
+}
+
+// AppendManyContext is AppendMany with ctx used for the call instead of the client context,
+// e.g. to give a single call a deadline
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - data: T [The data to append]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) AppendManyContext(ctx context.Context, dataSet string, table string, data []T) error {
 	if dataSet == "" {
 		return ErrInvalidDataset{Value: "dataset ID is required"}
 	}
@@ -59,13 +80,53 @@ func (b *bigQuery[T]) AppendMany(dataSet string, table string, data []T) error {
 	}
 
 	ins := b.client.Dataset(dataSet).Table(table).Inserter()
-	err := ins.Put(b.cfg.Context, data)
+	err := ins.Put(ctx, data)
 	if err != nil {
 		return ErrFailedToAppend{Value: fmt.Sprintf("failed to append multiple rows: %v", err)}
 	}
 	return nil
 }
 
+// AppendWithDedup appends a list of rows to a BigQuery table with an insert ID per row, so
+// BigQuery can drop the duplicates created when a failed insert is retried
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - data: []T [The rows to append, T must be a struct or a pointer to a struct]
+//   - idFunc: func(T) string [Returns the insert ID of a row, rows with an empty ID are not deduplicated]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) AppendWithDedup(dataSet string, table string, data []T, idFunc func(T) string) error {
+	if dataSet == "" {
+		return ErrInvalidDataset{Value: "dataset ID is required"}
+	}
+	if table == "" {
+		return ErrInvalidTable{Value: "table ID is required"}
+	}
+	if idFunc == nil {
+		return ErrInvalidIDFunc{Value: "idFunc is nil"}
+	}
+	if b.client == nil {
+		return ErrInvalidClient{Value: "client not initialized"}
+	}
+
+	// the schema of each row is inferred from T, and cached by the bigquery package
+	rows := make([]*bq.StructSaver, len(data))
+	for i, row := range data {
+		rows[i] = &bq.StructSaver{
+			Struct:   row,
+			InsertID: idFunc(row),
+		}
+	}
+	ins := b.client.Dataset(dataSet).Table(table).Inserter()
+	err := ins.Put(b.cfg.Context, rows)
+	if err != nil {
+		return ErrFailedToAppend{Value: fmt.Sprintf("failed to append rows with insert ids: %v", err)}
+	}
+	return nil
+}
+
 // Append adds a single row of JSON data to a BigQuery table
 // Parameters:
 //   - dataSet: string [The dataset ID]
@@ -75,6 +136,20 @@ func (b *bigQuery[T]) AppendMany(dataSet string, table string, data []T) error {
 // Returns:
 //   - error: An error if one occurs.
 func (b *bigQuery[T]) Append(dataSet string, table string, data T) error {
+	return b.AppendContext(b.cfg.Context, dataSet, table, data)
+}
+
+// AppendContext is Append with ctx used for the call instead of the client context,
+// e.g. to give a single call a deadline
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - data: []byte [The JSON data to add]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) AppendContext(ctx context.Context, dataSet string, table string, data T) error {
 	if dataSet == "" {
 		return ErrInvalidDataset{Value: "dataset ID is required"}
 	}
@@ -85,7 +160,7 @@ func (b *bigQuery[T]) Append(dataSet string, table string, data T) error {
 		return ErrInvalidClient{Value: "client not initialized"}
 	}
 	ins := b.client.Dataset(dataSet).Table(table).Inserter()
-	err := ins.Put(b.cfg.Context, data)
+	err := ins.Put(ctx, data)
 	if err != nil {
 		return ErrFailedToAppend{Value: fmt.Sprintf("failed to append row: %v", err)}
 	}
@@ -109,89 +184,298 @@ func (b *bigQuery[T]) ImportJsonFile(
 	schema bq.Schema,
 	writeDisposition bq.TableWriteDisposition,
 ) error {
-	if dataSet == "" {
-		return ErrInvalidDataset{Value: "dataset ID is required"}
-	}
-	if table == "" {
-		return ErrInvalidTable{Value: "table ID is required"}
-	}
-	if b.client == nil {
-		return ErrInvalidClient{Value: "client not initialized"}
-	}
+	return b.ImportJsonFileContext(b.cfg.Context, dataSet, table, gcsFile, schema, writeDisposition)
+}
 
-	gcsRef := bq.NewGCSReference(gcsFile)
-	gcsRef.SourceFormat = bq.JSON
-	gcsRef.Schema = schema
+// ImportJsonFileContext is ImportJsonFile with ctx used for the load job instead of the client
+// context, e.g. to give a single import a deadline
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - gcsFile: string [The Cloud Storage file to load]
+//   - schema: bq.Schema [The schema of the data]
+//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) ImportJsonFileContext(
+	ctx context.Context,
+	dataSet string,
+	table string,
+	gcsFile string,
+	schema bq.Schema,
+	writeDisposition bq.TableWriteDisposition,
+) error {
+	return b.ImportFilesContext(ctx, dataSet, table, []string{gcsFile}, bq.JSON, schema, writeDisposition)
+}
 
-	loader := b.client.Dataset(dataSet).Table(table).LoaderFrom(gcsRef)
-	loader.WriteDisposition = writeDisposition
+// ImportJsonFiles loads multiple JSON files from Cloud Storage to BigQuery
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - gcsFiles: []string [The Cloud Storage files to load]
+//   - schema: bq.Schema [The schema of the data]
+//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) ImportJsonFiles(
+	dataSet string,
+	table string,
+	gcsFiles []string,
+	schema bq.Schema,
+	writeDisposition bq.TableWriteDisposition,
+) error {
+	return b.ImportJsonFilesContext(b.cfg.Context, dataSet, table, gcsFiles, schema, writeDisposition)
+}
 
-	job, err := loader.Run(b.cfg.Context)
+// ImportJsonFilesContext is ImportJsonFiles with ctx used for the load job instead of the client
+// context, e.g. to give a single import a deadline
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - gcsFiles: []string [The Cloud Storage files to load]
+//   - schema: bq.Schema [The schema of the data]
+//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) ImportJsonFilesContext(
+	ctx context.Context,
+	dataSet string,
+	table string,
+	gcsFiles []string,
+	schema bq.Schema,
+	writeDisposition bq.TableWriteDisposition,
+) error {
+	return b.ImportFilesContext(ctx, dataSet, table, gcsFiles, bq.JSON, schema, writeDisposition)
+}
+
+// ImportFiles loads files of any source format supported by load jobs, e.g. Parquet or Avro,
+// from Cloud Storage to BigQuery
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - gcsFiles: []string [The Cloud Storage files to load]
+//   - format: bq.DataFormat [The source format: bq.JSON, bq.CSV, bq.Avro, bq.Parquet or bq.ORC]
+//   - schema: bq.Schema [The schema of the data, nil to read it from Avro, Parquet and ORC files]
+//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+//
+// Returns:
+//   - error: ErrInvalidFormat if the format can't be loaded from files, or an error if one occurs.
+func (b *bigQuery[T]) ImportFiles(
+	dataSet string,
+	table string,
+	gcsFiles []string,
+	format bq.DataFormat,
+	schema bq.Schema,
+	writeDisposition bq.TableWriteDisposition,
+) error {
+	return b.ImportFilesContext(b.cfg.Context, dataSet, table, gcsFiles, format, schema, writeDisposition)
+}
+
+// ImportFilesContext is ImportFiles with ctx used for the load job instead of the client context
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - gcsFiles: []string [The Cloud Storage files to load]
+//   - format: bq.DataFormat [The source format: bq.JSON, bq.CSV, bq.Avro, bq.Parquet or bq.ORC]
+//   - schema: bq.Schema [The schema of the data, nil to read it from Avro, Parquet and ORC files]
+//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+//
+// Returns:
+//   - error: ErrInvalidFormat if the format can't be loaded from files, or an error if one occurs.
+func (b *bigQuery[T]) ImportFilesContext(
+	ctx context.Context,
+	dataSet string,
+	table string,
+	gcsFiles []string,
+	format bq.DataFormat,
+	schema bq.Schema,
+	writeDisposition bq.TableWriteDisposition,
+) error {
+	job, err := b.startImportJob(ctx, dataSet, table, gcsFiles, format, schema, writeDisposition)
 	if err != nil {
-		return ErrFailedToImport{Value: fmt.Sprintf("failed to start import job: %v", err)}
+		return err
 	}
 
-	status, err := job.Wait(b.cfg.Context)
+	status, err := job.Wait(ctx)
 	if err != nil {
 		return ErrFailedToImport{Value: fmt.Sprintf("failed while waiting for import job: %v", err)}
 	}
 
-	if status.Err() != nil {
-		var errors []string
-		for _, e := range status.Errors {
-			errors = append(errors, e.Error())
-		}
-		return ErrFailedToImport{Value: fmt.Sprintf("import job failed: %s", strings.Join(errors, "; "))}
-	}
-
-	return nil
+	return importJobError(status)
 }
 
-// ImportJsonFiles loads multiple JSON files from Cloud Storage to BigQuery
+// ImportJsonFilesWithProgress is ImportJsonFiles reporting the progress of the load job: the job
+// status is polled every Config.ProgressInterval and passed to progress, until the job is done
 // Parameters:
 //   - dataSet: string [The dataset ID]
 //   - table: string [The table ID]
 //   - gcsFiles: []string [The Cloud Storage files to load]
 //   - schema: bq.Schema [The schema of the data]
 //   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+//   - progress: func(JobStatus) [Called with every polled status, the last one with State bq.Done]
 //
 // Returns:
 //   - error: An error if one occurs.
-func (b *bigQuery[T]) ImportJsonFiles(
+func (b *bigQuery[T]) ImportJsonFilesWithProgress(
 	dataSet string,
 	table string,
 	gcsFiles []string,
 	schema bq.Schema,
 	writeDisposition bq.TableWriteDisposition,
+	progress func(JobStatus),
 ) error {
+	ctx := b.cfg.Context
+	job, err := b.startImportJob(ctx, dataSet, table, gcsFiles, bq.JSON, schema, writeDisposition)
+	if err != nil {
+		return err
+	}
+
+	interval := b.cfg.ProgressInterval
+	if interval <= 0 {
+		interval = defaultProgressInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status, err := job.Status(ctx)
+		if err != nil {
+			return ErrFailedToImport{Value: fmt.Sprintf("failed while polling import job: %v", err)}
+		}
+		if progress != nil {
+			progress(newJobStatus(job.ID(), status))
+		}
+		if status.Done() {
+			return importJobError(status)
+		}
+		select {
+		case <-ctx.Done():
+			return ErrFailedToImport{Value: fmt.Sprintf("failed while waiting for import job: %v", ctx.Err())}
+		case <-ticker.C:
+		}
+	}
+}
+
+// startImportJob validates the arguments of an import and starts its load job.
+func (b *bigQuery[T]) startImportJob(
+	ctx context.Context,
+	dataSet string,
+	table string,
+	gcsFiles []string,
+	format bq.DataFormat,
+	schema bq.Schema,
+	writeDisposition bq.TableWriteDisposition,
+) (*bq.Job, error) {
 	if dataSet == "" {
-		return ErrInvalidDataset{Value: "dataset ID is required"}
+		return nil, ErrInvalidDataset{Value: "dataset ID is required"}
 	}
 	if table == "" {
-		return ErrInvalidTable{Value: "table ID is required"}
+		return nil, ErrInvalidTable{Value: "table ID is required"}
 	}
 	if len(gcsFiles) == 0 {
-		return ErrInvalidTable{Value: "no files provided"}
+		return nil, ErrInvalidTable{Value: "no files provided"}
+	}
+	switch format {
+	case bq.JSON, bq.CSV, bq.Avro, bq.Parquet, bq.ORC:
+	default:
+		return nil, ErrInvalidFormat{Value: string(format)}
 	}
 	if b.client == nil {
-		return ErrInvalidClient{Value: "client not initialized"}
+		return nil, ErrInvalidClient{Value: "client not initialized"}
 	}
 
 	gcsRef := bq.NewGCSReference(gcsFiles...)
-	gcsRef.SourceFormat = bq.JSON
+	gcsRef.SourceFormat = format
 	gcsRef.Schema = schema
 
 	loader := b.client.Dataset(dataSet).Table(table).LoaderFrom(gcsRef)
 	loader.WriteDisposition = writeDisposition
 
-	job, err := loader.Run(b.cfg.Context)
+	job, err := loader.Run(ctx)
 	if err != nil {
-		return ErrFailedToImport{Value: fmt.Sprintf("failed to start import job: %v", err)}
+		return nil, ErrFailedToImport{Value: fmt.Sprintf("failed to start import job: %v", err)}
+	}
+	return job, nil
+}
+
+// importJobError returns ErrFailedToImport with the errors of a failed import job, nil otherwise.
+func importJobError(status *bq.JobStatus) error {
+	if status.Err() == nil {
+		return nil
+	}
+	var errors []string
+	for _, e := range status.Errors {
+		errors = append(errors, e.Error())
+	}
+	return ErrFailedToImport{Value: fmt.Sprintf("import job failed: %s", strings.Join(errors, "; "))}
+}
+
+// newJobStatus returns the JobStatus of the load job jobID.
+func newJobStatus(jobID string, status *bq.JobStatus) JobStatus {
+	jobStatus := JobStatus{JobID: jobID, State: status.State}
+	if status.Statistics == nil {
+		return jobStatus
+	}
+	jobStatus.StartTime = status.Statistics.StartTime
+	if load, ok := status.Statistics.Details.(*bq.LoadStatistics); ok {
+		jobStatus.InputFiles = load.InputFiles
+		jobStatus.InputFileBytes = load.InputFileBytes
+		jobStatus.OutputRows = load.OutputRows
+		jobStatus.OutputBytes = load.OutputBytes
+	}
+	return jobStatus
+}
+
+// CopyTable copies a table into another table using a copy job and waits for it to complete
+// Parameters:
+//   - srcDataSet: string [The source dataset ID]
+//   - srcTable: string [The source table ID]
+//   - dstDataSet: string [The destination dataset ID]
+//   - dstTable: string [The destination table ID]
+//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) CopyTable(
+	srcDataSet string,
+	srcTable string,
+	dstDataSet string,
+	dstTable string,
+	writeDisposition bq.TableWriteDisposition,
+) error {
+	if srcDataSet == "" {
+		return ErrInvalidDataset{Value: "source dataset ID is required"}
+	}
+	if srcTable == "" {
+		return ErrInvalidTable{Value: "source table ID is required"}
+	}
+	if dstDataSet == "" {
+		return ErrInvalidDataset{Value: "destination dataset ID is required"}
+	}
+	if dstTable == "" {
+		return ErrInvalidTable{Value: "destination table ID is required"}
+	}
+	if b.client == nil {
+		return ErrInvalidClient{Value: "client not initialized"}
+	}
+
+	src := b.client.Dataset(srcDataSet).Table(srcTable)
+	copier := b.client.Dataset(dstDataSet).Table(dstTable).CopierFrom(src)
+	copier.WriteDisposition = writeDisposition
+
+	job, err := copier.Run(b.cfg.Context)
+	if err != nil {
+		return ErrFailedToCopy{Value: fmt.Sprintf("failed to start copy job: %v", err)}
 	}
 
 	status, err := job.Wait(b.cfg.Context)
 	if err != nil {
-		return ErrFailedToImport{Value: fmt.Sprintf("failed while waiting for import job: %v", err)}
+		return ErrFailedToCopy{Value: fmt.Sprintf("failed while waiting for copy job: %v", err)}
 	}
 
 	if status.Err() != nil {
@@ -199,12 +483,230 @@ func (b *bigQuery[T]) ImportJsonFiles(
 		for _, e := range status.Errors {
 			errors = append(errors, e.Error())
 		}
-		return ErrFailedToImport{Value: fmt.Sprintf("import job failed: %s", strings.Join(errors, "; "))}
+		return ErrFailedToCopy{Value: fmt.Sprintf("copy job failed: %s", strings.Join(errors, "; "))}
 	}
 
 	return nil
 }
 
+// identifierRegexp is the format accepted for dataset and table IDs interpolated into queries.
+var identifierRegexp = regexp.MustCompile(`^[\w-]+$`)
+
+// Count counts the rows of a table matching an optional where clause. Values should be passed
+// as query parameters referenced from the where clause, e.g. "status = @status", rather than
+// formatted into it.
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - whereClause: string [The condition rows must match, may be empty]
+//   - params: ...bq.QueryParameter [The parameters referenced from the where clause]
+//
+// Returns:
+//   - int64: The number of matching rows
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) Count(
+	dataSet string,
+	table string,
+	whereClause string,
+	params ...bq.QueryParameter,
+) (int64, error) {
+	if dataSet == "" {
+		return 0, ErrInvalidDataset{Value: "dataset ID is required"}
+	}
+	if !identifierRegexp.MatchString(dataSet) {
+		return 0, ErrInvalidDataset{Value: fmt.Sprintf("invalid dataset ID %q", dataSet)}
+	}
+	if table == "" {
+		return 0, ErrInvalidTable{Value: "table ID is required"}
+	}
+	if !identifierRegexp.MatchString(table) {
+		return 0, ErrInvalidTable{Value: fmt.Sprintf("invalid table ID %q", table)}
+	}
+	if b.client == nil {
+		return 0, ErrInvalidClient{Value: "client not initialized"}
+	}
+
+	sql := fmt.Sprintf("SELECT COUNT(*) FROM `%s.%s`", dataSet, table)
+	if whereClause != "" {
+		sql += " WHERE " + whereClause
+	}
+	query := b.client.Query(sql)
+	query.Parameters = params
+	it, err := query.Read(b.cfg.Context)
+	if err != nil {
+		return 0, ErrQueryExecution{Value: fmt.Sprintf("query execution failed: %v", err)}
+	}
+
+	var row []bq.Value
+	if err := it.Next(&row); err != nil {
+		return 0, ErrFailedToRead{Value: fmt.Sprintf("failed to read row: %v", err)}
+	}
+	count, ok := row[0].(int64)
+	if !ok {
+		return 0, ErrFailedToRead{Value: fmt.Sprintf("unexpected count value %v", row[0])}
+	}
+	return count, nil
+}
+
+// TableExists reports whether a table exists using the table metadata
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//
+// Returns:
+//   - bool: true if the table exists
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) TableExists(dataSet string, table string) (bool, error) {
+	if dataSet == "" {
+		return false, ErrInvalidDataset{Value: "dataset ID is required"}
+	}
+	if table == "" {
+		return false, ErrInvalidTable{Value: "table ID is required"}
+	}
+	if b.client == nil {
+		return false, ErrInvalidClient{Value: "client not initialized"}
+	}
+
+	_, err := b.client.Dataset(dataSet).Table(table).Metadata(b.cfg.Context)
+	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return false, nil
+		}
+		return false, ErrFailedToRead{Value: fmt.Sprintf("failed to get table metadata: %v", err)}
+	}
+	return true, nil
+}
+
+// DeleteTable deletes a table and its data
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//
+// Returns:
+//   - error: ErrInvalidTable if the table does not exist, or an error if one occurs.
+func (b *bigQuery[T]) DeleteTable(dataSet string, table string) error {
+	if dataSet == "" {
+		return ErrInvalidDataset{Value: "dataset ID is required"}
+	}
+	if table == "" {
+		return ErrInvalidTable{Value: "table ID is required"}
+	}
+	if b.client == nil {
+		return ErrInvalidClient{Value: "client not initialized"}
+	}
+
+	err := b.client.Dataset(dataSet).Table(table).Delete(b.cfg.Context)
+	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return ErrInvalidTable{Value: fmt.Sprintf("table %s.%s not found", dataSet, table)}
+		}
+		return ErrFailedToDelete{Value: fmt.Sprintf("failed to delete table: %v", err)}
+	}
+	return nil
+}
+
+// TruncateTable deletes all the rows of a table, keeping its schema, with a TRUNCATE TABLE statement
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//
+// Returns:
+//   - error: ErrInvalidTable if the table does not exist, or an error if one occurs.
+func (b *bigQuery[T]) TruncateTable(dataSet string, table string) error {
+	if dataSet == "" {
+		return ErrInvalidDataset{Value: "dataset ID is required"}
+	}
+	if !identifierRegexp.MatchString(dataSet) {
+		return ErrInvalidDataset{Value: fmt.Sprintf("invalid dataset ID %q", dataSet)}
+	}
+	if table == "" {
+		return ErrInvalidTable{Value: "table ID is required"}
+	}
+	if !identifierRegexp.MatchString(table) {
+		return ErrInvalidTable{Value: fmt.Sprintf("invalid table ID %q", table)}
+	}
+	// the TRUNCATE statement fails with a generic job error on a missing table, so the
+	// table is looked up first to report it as ErrInvalidTable
+	if _, err := b.tableMetadata(dataSet, table); err != nil {
+		return err
+	}
+
+	job, err := b.client.Query(fmt.Sprintf("TRUNCATE TABLE `%s.%s`", dataSet, table)).Run(b.cfg.Context)
+	if err != nil {
+		return ErrFailedToDelete{Value: fmt.Sprintf("failed to start truncate job: %v", err)}
+	}
+	status, err := job.Wait(b.cfg.Context)
+	if err != nil {
+		return ErrFailedToDelete{Value: fmt.Sprintf("failed while waiting for truncate job: %v", err)}
+	}
+	if status.Err() != nil {
+		return ErrFailedToDelete{Value: fmt.Sprintf("truncate job failed: %v", status.Err())}
+	}
+	return nil
+}
+
+// GetTableSchema retrieves the schema of a table using the table metadata
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//
+// Returns:
+//   - bq.Schema: The schema of the table
+//   - error: ErrInvalidTable if the table does not exist, or an error if one occurs.
+func (b *bigQuery[T]) GetTableSchema(dataSet string, table string) (bq.Schema, error) {
+	md, err := b.tableMetadata(dataSet, table)
+	if err != nil {
+		return nil, err
+	}
+	return md.Schema, nil
+}
+
+// GetTableMetadata retrieves the row count, size and last modification time of a table
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//
+// Returns:
+//   - *TableMetadata: The storage metadata of the table
+//   - error: ErrInvalidTable if the table does not exist, or an error if one occurs.
+func (b *bigQuery[T]) GetTableMetadata(dataSet string, table string) (*TableMetadata, error) {
+	md, err := b.tableMetadata(dataSet, table)
+	if err != nil {
+		return nil, err
+	}
+	return &TableMetadata{
+		NumRows:      md.NumRows,
+		NumBytes:     md.NumBytes,
+		LastModified: md.LastModifiedTime,
+	}, nil
+}
+
+// tableMetadata validates the dataset and table IDs and reads the table metadata, returning
+// ErrInvalidTable when the table does not exist.
+func (b *bigQuery[T]) tableMetadata(dataSet string, table string) (*bq.TableMetadata, error) {
+	if dataSet == "" {
+		return nil, ErrInvalidDataset{Value: "dataset ID is required"}
+	}
+	if table == "" {
+		return nil, ErrInvalidTable{Value: "table ID is required"}
+	}
+	if b.client == nil {
+		return nil, ErrInvalidClient{Value: "client not initialized"}
+	}
+
+	md, err := b.client.Dataset(dataSet).Table(table).Metadata(b.cfg.Context)
+	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return nil, ErrInvalidTable{Value: fmt.Sprintf("table %s.%s not found", dataSet, table)}
+		}
+		return nil, ErrFailedToRead{Value: fmt.Sprintf("failed to get table metadata: %v", err)}
+	}
+	return md, nil
+}
+
 // ExecuteQuery executes a BigQuery query and returns the results as a list of rows
 // Parameters:
 //   - sql: string [The SQL query]
@@ -213,6 +715,46 @@ func (b *bigQuery[T]) ImportJsonFiles(
 //   - []T: The results of the query
 //   - error: An error if one occurs.
 func (b *bigQuery[T]) ExecuteQuery(sql string) ([]T, error) {
+	return b.ExecuteQueryWithOptionsContext(b.cfg.Context, sql, QueryOptions{})
+}
+
+// ExecuteQueryContext is ExecuteQuery with ctx used for the query instead of the client context,
+// e.g. to give a single query a deadline
+// Parameters:
+//   - ctx: context.Context [The context of the query]
+//   - sql: string [The SQL query]
+//
+// Returns:
+//   - []T: The results of the query
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) ExecuteQueryContext(ctx context.Context, sql string) ([]T, error) {
+	return b.ExecuteQueryWithOptionsContext(ctx, sql, QueryOptions{})
+}
+
+// ExecuteQueryWithOptions executes a BigQuery query job configured with opts and returns
+// the results as a list of rows
+// Parameters:
+//   - sql: string [The SQL query]
+//   - opts: QueryOptions [The labels, priority and SQL dialect of the query job]
+//
+// Returns:
+//   - []T: The results of the query
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) ExecuteQueryWithOptions(sql string, opts QueryOptions) ([]T, error) {
+	return b.ExecuteQueryWithOptionsContext(b.cfg.Context, sql, opts)
+}
+
+// ExecuteQueryWithOptionsContext is ExecuteQueryWithOptions with ctx used for the query instead
+// of the client context
+// Parameters:
+//   - ctx: context.Context [The context of the query]
+//   - sql: string [The SQL query]
+//   - opts: QueryOptions [The labels, priority and SQL dialect of the query job]
+//
+// Returns:
+//   - []T: The results of the query
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) ExecuteQueryWithOptionsContext(ctx context.Context, sql string, opts QueryOptions) ([]T, error) {
 	if sql == "" {
 		return nil, ErrInvalidQuery{Value: "SQL query cannot be empty"}
 	}
@@ -221,7 +763,10 @@ func (b *bigQuery[T]) ExecuteQuery(sql string) ([]T, error) {
 	}
 
 	query := b.client.Query(sql)
-	it, err := query.Read(b.cfg.Context)
+	query.Labels = opts.Labels
+	query.Priority = opts.Priority
+	query.UseLegacySQL = opts.UseLegacySQL
+	it, err := query.Read(ctx)
 	if err != nil {
 		return nil, ErrQueryExecution{Value: fmt.Sprintf("query execution failed: %v", err)}
 	}
@@ -241,3 +786,89 @@ func (b *bigQuery[T]) ExecuteQuery(sql string) ([]T, error) {
 
 	return results, nil
 }
+
+// QueryToStruct executes a BigQuery query and decodes each row into T, mapping columns to
+// fields through mapping (column name to field name), then the fields' `bq` tags, then the
+// field names, all matched case-insensitively. Columns without a matching field are ignored.
+// Parameters:
+//   - sql: string [The SQL query]
+//   - mapping: map[string]string [The column to field name mapping, may be nil]
+//
+// Returns:
+//   - []T: The results of the query
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) QueryToStruct(sql string, mapping map[string]string) ([]T, error) {
+	if sql == "" {
+		return nil, ErrInvalidQuery{Value: "SQL query cannot be empty"}
+	}
+	columns, err := columnFields(reflect.TypeOf((*T)(nil)).Elem(), mapping)
+	if err != nil {
+		return nil, err
+	}
+	if b.client == nil {
+		return nil, ErrInvalidClient{Value: "client not initialized"}
+	}
+
+	query := b.client.Query(sql)
+	it, err := query.Read(b.cfg.Context)
+	if err != nil {
+		return nil, ErrQueryExecution{Value: fmt.Sprintf("query execution failed: %v", err)}
+	}
+
+	var results []T
+	for {
+		row := map[string]bq.Value{}
+		err := it.Next(&row)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return results, ErrFailedToRead{Value: fmt.Sprintf("failed to read row: %v", err)}
+		}
+		var t T
+		if err := decodeRow(row, reflect.ValueOf(&t).Elem(), columns); err != nil {
+			return results, err
+		}
+		results = append(results, t)
+	}
+
+	return results, nil
+}
+
+// ExecuteQueryRows executes a BigQuery query and returns the rows keyed by column name, for
+// results that don't map to T, e.g. ad-hoc analytics
+// Parameters:
+//   - sql: string [The SQL query]
+//
+// Returns:
+//   - []Row: The results of the query
+//   - error: An error if one occurs.
+func (b *bigQuery[T]) ExecuteQueryRows(sql string) ([]Row, error) {
+	if sql == "" {
+		return nil, ErrInvalidQuery{Value: "SQL query cannot be empty"}
+	}
+	if b.client == nil {
+		return nil, ErrInvalidClient{Value: "client not initialized"}
+	}
+
+	it, err := b.client.Query(sql).Read(b.cfg.Context)
+	if err != nil {
+		return nil, ErrQueryExecution{Value: fmt.Sprintf("query execution failed: %v", err)}
+	}
+
+	var results []Row
+	for {
+		// the iterator only loads into a plain map, not into a Row
+		row := map[string]bq.Value{}
+		err := it.Next(&row)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return results, ErrFailedToRead{Value: fmt.Sprintf("failed to read row: %v", err)}
+		}
+		results = append(results, Row(row))
+	}
+
+	return results, nil
+}
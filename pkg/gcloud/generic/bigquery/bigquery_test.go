@@ -3,6 +3,7 @@ package bigquery_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	bq "cloud.google.com/go/bigquery"
 	"github.com/pal-paul/go-libraries/pkg/gcloud/generic/bigquery"
@@ -153,6 +154,58 @@ func TestBigQueryAppendMany(t *testing.T) {
 	}
 }
 
+func TestBigQueryAppendWithDedup(t *testing.T) {
+	data := []TestData{
+		{Name: "John", Age: 30},
+		{Name: "Jane", Age: 25},
+	}
+	idFunc := func(row TestData) string { return row.Name }
+
+	tests := []struct {
+		name      string
+		dataset   string
+		table     string
+		idFunc    func(TestData) string
+		errorType error
+	}{
+		{
+			name:      "error with empty dataset",
+			dataset:   "",
+			table:     "test-table",
+			idFunc:    idFunc,
+			errorType: bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:      "error with empty table",
+			dataset:   "test-dataset",
+			table:     "",
+			idFunc:    idFunc,
+			errorType: bigquery.ErrInvalidTable{},
+		},
+		{
+			name:      "error with nil id function",
+			dataset:   "test-dataset",
+			table:     "test-table",
+			idFunc:    nil,
+			errorType: bigquery.ErrInvalidIDFunc{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := bigquery.New[TestData](
+				bigquery.WithProjectId("test-project"),
+				bigquery.WithContext(context.Background()),
+			)
+			assert.NoError(t, err)
+
+			err = client.AppendWithDedup(tt.dataset, tt.table, data, tt.idFunc)
+			assert.Error(t, err)
+			assert.IsType(t, tt.errorType, err)
+		})
+	}
+}
+
 func TestBigQueryImportJsonFile(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -278,6 +331,64 @@ func TestBigQueryImportJsonFiles(t *testing.T) {
 	}
 }
 
+func TestBigQueryImportFiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataset   string
+		table     string
+		gcsFiles  []string
+		format    bq.DataFormat
+		errorType error
+	}{
+		{
+			name:      "error with empty dataset",
+			dataset:   "",
+			table:     "test-table",
+			gcsFiles:  []string{"gs://bucket/file1.parquet"},
+			format:    bq.Parquet,
+			errorType: bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:      "error with empty table",
+			dataset:   "test-dataset",
+			table:     "",
+			gcsFiles:  []string{"gs://bucket/file1.avro"},
+			format:    bq.Avro,
+			errorType: bigquery.ErrInvalidTable{},
+		},
+		{
+			name:      "error with empty files list",
+			dataset:   "test-dataset",
+			table:     "test-table",
+			gcsFiles:  []string{},
+			format:    bq.Parquet,
+			errorType: bigquery.ErrInvalidTable{},
+		},
+		{
+			name:      "error with unsupported format",
+			dataset:   "test-dataset",
+			table:     "test-table",
+			gcsFiles:  []string{"gs://bucket/sheet"},
+			format:    bq.GoogleSheets,
+			errorType: bigquery.ErrInvalidFormat{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := bigquery.New[TestData](
+				bigquery.WithProjectId("test-project"),
+				bigquery.WithContext(context.Background()),
+			)
+			assert.NoError(t, err)
+
+			err = client.ImportFiles(tt.dataset, tt.table, tt.gcsFiles, tt.format, nil, bq.WriteAppend)
+			assert.Error(t, err)
+			assert.IsType(t, tt.errorType, err)
+		})
+	}
+}
+
 func TestBigQueryExecuteQuery(t *testing.T) {
 	t.Run("error with empty query", func(t *testing.T) {
 		client, err := bigquery.New[TestData](
@@ -292,3 +403,474 @@ func TestBigQueryExecuteQuery(t *testing.T) {
 		assert.IsType(t, bigquery.ErrInvalidQuery{}, err)
 	})
 }
+
+func TestBigQueryCopyTable(t *testing.T) {
+	tests := []struct {
+		name       string
+		srcDataset string
+		srcTable   string
+		dstDataset string
+		dstTable   string
+		wantError  bool
+		errorType  error
+	}{
+		{
+			name:       "error with empty source dataset",
+			srcDataset: "",
+			srcTable:   "test-table",
+			dstDataset: "test-dataset",
+			dstTable:   "test-table",
+			wantError:  true,
+			errorType:  bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:       "error with empty source table",
+			srcDataset: "test-dataset",
+			srcTable:   "",
+			dstDataset: "test-dataset",
+			dstTable:   "test-table",
+			wantError:  true,
+			errorType:  bigquery.ErrInvalidTable{},
+		},
+		{
+			name:       "error with empty destination dataset",
+			srcDataset: "test-dataset",
+			srcTable:   "test-table",
+			dstDataset: "",
+			dstTable:   "test-table",
+			wantError:  true,
+			errorType:  bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:       "error with empty destination table",
+			srcDataset: "test-dataset",
+			srcTable:   "test-table",
+			dstDataset: "test-dataset",
+			dstTable:   "",
+			wantError:  true,
+			errorType:  bigquery.ErrInvalidTable{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := bigquery.New[TestData](
+				bigquery.WithProjectId("test-project"),
+				bigquery.WithContext(context.Background()),
+			)
+			assert.NoError(t, err)
+
+			err = client.CopyTable(tt.srcDataset, tt.srcTable, tt.dstDataset, tt.dstTable, bq.WriteTruncate)
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.IsType(t, tt.errorType, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBigQueryQueryToStruct(t *testing.T) {
+	tests := []struct {
+		name      string
+		sql       string
+		mapping   map[string]string
+		errorType error
+	}{
+		{
+			name:      "error with empty query",
+			sql:       "",
+			errorType: bigquery.ErrInvalidQuery{},
+		},
+		{
+			name:      "error with unknown mapped field",
+			sql:       "SELECT full_name FROM dataset.table",
+			mapping:   map[string]string{"full_name": "FullName"},
+			errorType: bigquery.ErrInvalidMapping{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := bigquery.New[TestData](
+				bigquery.WithProjectId("test-project"),
+				bigquery.WithContext(context.Background()),
+			)
+			assert.NoError(t, err)
+
+			results, err := client.QueryToStruct(tt.sql, tt.mapping)
+			assert.Error(t, err)
+			assert.Nil(t, results)
+			assert.IsType(t, tt.errorType, err)
+		})
+	}
+}
+
+func TestBigQueryCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataset   string
+		table     string
+		errorType error
+	}{
+		{
+			name:      "error with empty dataset",
+			dataset:   "",
+			table:     "test-table",
+			errorType: bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:      "error with invalid dataset",
+			dataset:   "test`; DROP TABLE x; --",
+			table:     "test-table",
+			errorType: bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:      "error with empty table",
+			dataset:   "test-dataset",
+			table:     "",
+			errorType: bigquery.ErrInvalidTable{},
+		},
+		{
+			name:      "error with invalid table",
+			dataset:   "test-dataset",
+			table:     "other.table",
+			errorType: bigquery.ErrInvalidTable{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := bigquery.New[TestData](
+				bigquery.WithProjectId("test-project"),
+				bigquery.WithContext(context.Background()),
+			)
+			assert.NoError(t, err)
+
+			count, err := client.Count(tt.dataset, tt.table, "name = @name", bq.QueryParameter{Name: "name", Value: "test"})
+			assert.Error(t, err)
+			assert.Zero(t, count)
+			assert.IsType(t, tt.errorType, err)
+		})
+	}
+}
+
+func TestBigQueryTableExists(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataset   string
+		table     string
+		errorType error
+	}{
+		{
+			name:      "error with empty dataset",
+			dataset:   "",
+			table:     "test-table",
+			errorType: bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:      "error with empty table",
+			dataset:   "test-dataset",
+			table:     "",
+			errorType: bigquery.ErrInvalidTable{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := bigquery.New[TestData](
+				bigquery.WithProjectId("test-project"),
+				bigquery.WithContext(context.Background()),
+			)
+			assert.NoError(t, err)
+
+			exists, err := client.TableExists(tt.dataset, tt.table)
+			assert.Error(t, err)
+			assert.False(t, exists)
+			assert.IsType(t, tt.errorType, err)
+		})
+	}
+}
+
+func TestBigQueryTableMetadata(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataset   string
+		table     string
+		errorType error
+	}{
+		{
+			name:      "error with empty dataset",
+			dataset:   "",
+			table:     "test-table",
+			errorType: bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:      "error with empty table",
+			dataset:   "test-dataset",
+			table:     "",
+			errorType: bigquery.ErrInvalidTable{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := bigquery.New[TestData](
+				bigquery.WithProjectId("test-project"),
+				bigquery.WithContext(context.Background()),
+			)
+			assert.NoError(t, err)
+
+			schema, err := client.GetTableSchema(tt.dataset, tt.table)
+			assert.Error(t, err)
+			assert.Nil(t, schema)
+			assert.IsType(t, tt.errorType, err)
+
+			md, err := client.GetTableMetadata(tt.dataset, tt.table)
+			assert.Error(t, err)
+			assert.Nil(t, md)
+			assert.IsType(t, tt.errorType, err)
+		})
+	}
+}
+
+func TestBigQueryDeleteAndTruncateTable(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataset   string
+		table     string
+		errorType error
+	}{
+		{
+			name:      "error with empty dataset",
+			dataset:   "",
+			table:     "test-table",
+			errorType: bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:      "error with empty table",
+			dataset:   "test-dataset",
+			table:     "",
+			errorType: bigquery.ErrInvalidTable{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := bigquery.New[TestData](
+				bigquery.WithProjectId("test-project"),
+				bigquery.WithContext(context.Background()),
+			)
+			assert.NoError(t, err)
+
+			err = client.DeleteTable(tt.dataset, tt.table)
+			assert.Error(t, err)
+			assert.IsType(t, tt.errorType, err)
+
+			err = client.TruncateTable(tt.dataset, tt.table)
+			assert.Error(t, err)
+			assert.IsType(t, tt.errorType, err)
+		})
+	}
+
+	t.Run("error with invalid table ID", func(t *testing.T) {
+		client, err := bigquery.New[TestData](
+			bigquery.WithProjectId("test-project"),
+			bigquery.WithContext(context.Background()),
+		)
+		assert.NoError(t, err)
+
+		err = client.TruncateTable("test-dataset", "test-table`; DROP TABLE x")
+		assert.Error(t, err)
+		assert.IsType(t, bigquery.ErrInvalidTable{}, err)
+	})
+}
+
+func TestBigQueryExecuteQueryWithOptions(t *testing.T) {
+	t.Run("error with empty query", func(t *testing.T) {
+		client, err := bigquery.New[TestData](
+			bigquery.WithProjectId("test-project"),
+			bigquery.WithContext(context.Background()),
+		)
+		assert.NoError(t, err)
+
+		results, err := client.ExecuteQueryWithOptions("", bigquery.QueryOptions{
+			Labels:   map[string]string{"team": "data"},
+			Priority: bq.BatchPriority,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, results)
+		assert.IsType(t, bigquery.ErrInvalidQuery{}, err)
+	})
+}
+
+func TestBigQueryExecuteQueryRows(t *testing.T) {
+	t.Run("error with empty query", func(t *testing.T) {
+		client, err := bigquery.New[TestData](
+			bigquery.WithProjectId("test-project"),
+			bigquery.WithContext(context.Background()),
+		)
+		assert.NoError(t, err)
+
+		rows, err := client.ExecuteQueryRows("")
+		assert.Error(t, err)
+		assert.Nil(t, rows)
+		assert.IsType(t, bigquery.ErrInvalidQuery{}, err)
+	})
+}
+
+func TestBigQueryContextVariants(t *testing.T) {
+	client, err := bigquery.New[TestData](
+		bigquery.WithProjectId("test-project"),
+		bigquery.WithContext(context.Background()),
+	)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	t.Run("execute query with empty query", func(t *testing.T) {
+		results, err := client.ExecuteQueryContext(ctx, "")
+		assert.Nil(t, results)
+		assert.IsType(t, bigquery.ErrInvalidQuery{}, err)
+	})
+
+	t.Run("execute query with options with empty query", func(t *testing.T) {
+		results, err := client.ExecuteQueryWithOptionsContext(ctx, "", bigquery.QueryOptions{})
+		assert.Nil(t, results)
+		assert.IsType(t, bigquery.ErrInvalidQuery{}, err)
+	})
+
+	t.Run("append with empty dataset", func(t *testing.T) {
+		err := client.AppendContext(ctx, "", "test-table", TestData{Name: "John", Age: 30})
+		assert.IsType(t, bigquery.ErrInvalidDataset{}, err)
+	})
+
+	t.Run("append many with empty table", func(t *testing.T) {
+		err := client.AppendManyContext(ctx, "test-dataset", "", []TestData{{Name: "John", Age: 30}})
+		assert.IsType(t, bigquery.ErrInvalidTable{}, err)
+	})
+
+	t.Run("import json file with empty dataset", func(t *testing.T) {
+		err := client.ImportJsonFileContext(ctx, "", "test-table", "gs://bucket/file.json", bq.Schema{}, bq.WriteAppend)
+		assert.IsType(t, bigquery.ErrInvalidDataset{}, err)
+	})
+
+	t.Run("import json files with empty table", func(t *testing.T) {
+		err := client.ImportJsonFilesContext(ctx, "test-dataset", "", []string{"gs://bucket/file.json"}, bq.Schema{}, bq.WriteAppend)
+		assert.IsType(t, bigquery.ErrInvalidTable{}, err)
+	})
+}
+
+func TestBigQueryMerge(t *testing.T) {
+	client, err := bigquery.New[TestData](
+		bigquery.WithProjectId("test-project"),
+		bigquery.WithContext(context.Background()),
+	)
+	assert.NoError(t, err)
+	rows := []TestData{{Name: "John", Age: 30}}
+
+	tests := []struct {
+		name       string
+		dataset    string
+		table      string
+		keyColumns []string
+		errorType  error
+	}{
+		{
+			name:       "error with empty dataset",
+			table:      "test-table",
+			keyColumns: []string{"name"},
+			errorType:  bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:       "error with invalid table ID",
+			dataset:    "test-dataset",
+			table:      "test-table`; DROP TABLE x",
+			keyColumns: []string{"name"},
+			errorType:  bigquery.ErrInvalidTable{},
+		},
+		{
+			name:      "error without key columns",
+			dataset:   "test-dataset",
+			table:     "test-table",
+			errorType: bigquery.ErrInvalidKeyColumns{},
+		},
+		{
+			name:       "error with unknown key column",
+			dataset:    "test-dataset",
+			table:      "test-table",
+			keyColumns: []string{"id"},
+			errorType:  bigquery.ErrInvalidKeyColumns{},
+		},
+		{
+			name:       "error with repeated key column",
+			dataset:    "test-dataset",
+			table:      "test-table",
+			keyColumns: []string{"name", "NAME"},
+			errorType:  bigquery.ErrInvalidKeyColumns{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.Merge(tt.dataset, tt.table, rows, tt.keyColumns)
+			assert.Error(t, err)
+			assert.IsType(t, tt.errorType, err)
+		})
+	}
+
+	t.Run("no rows", func(t *testing.T) {
+		assert.NoError(t, client.Merge("test-dataset", "test-table", nil, []string{"name"}))
+	})
+}
+
+func TestBigQueryImportJsonFilesWithProgress(t *testing.T) {
+	client, err := bigquery.New[TestData](
+		bigquery.WithProjectId("test-project"),
+		bigquery.WithContext(context.Background()),
+		bigquery.WithProgressInterval(time.Second),
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		dataset   string
+		table     string
+		gcsFiles  []string
+		errorType error
+	}{
+		{
+			name:      "error with empty dataset",
+			table:     "test-table",
+			gcsFiles:  []string{"gs://bucket/file.json"},
+			errorType: bigquery.ErrInvalidDataset{},
+		},
+		{
+			name:      "error with empty table",
+			dataset:   "test-dataset",
+			gcsFiles:  []string{"gs://bucket/file.json"},
+			errorType: bigquery.ErrInvalidTable{},
+		},
+		{
+			name:      "error without files",
+			dataset:   "test-dataset",
+			table:     "test-table",
+			errorType: bigquery.ErrInvalidTable{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			err := client.ImportJsonFilesWithProgress(tt.dataset, tt.table, tt.gcsFiles, bq.Schema{}, bq.WriteAppend, func(bigquery.JobStatus) {
+				called = true
+			})
+			assert.IsType(t, tt.errorType, err)
+			assert.False(t, called)
+		})
+	}
+
+	assert.Panics(t, func() { bigquery.WithProgressInterval(0) })
+}
@@ -1,6 +1,12 @@
 package bigquery
 
-import "context"
+import (
+	"context"
+	"time"
+)
+
+// defaultProgressInterval is how often ImportJsonFilesWithProgress polls the load job by default.
+const defaultProgressInterval = 5 * time.Second
 
 type Config struct {
 	// ProjectId is the Google Cloud Project ID
@@ -8,6 +14,9 @@ type Config struct {
 
 	// Context is the context to use for BigQuery operations
 	Context context.Context
+
+	// ProgressInterval is how often ImportJsonFilesWithProgress polls the load job
+	ProgressInterval time.Duration
 }
 type Option func(cfg *Config)
 
@@ -37,8 +46,20 @@ func WithContext(ctx context.Context) Option {
 	}
 }
 
+// WithProgressInterval sets how often ImportJsonFilesWithProgress polls the status of the load
+// job, 5 seconds by default
+func WithProgressInterval(d time.Duration) Option {
+	if d <= 0 {
+		panic("progress interval must be positive")
+	}
+	return func(cfg *Config) {
+		cfg.ProgressInterval = d
+	}
+}
+
 func defaultConfig() *Config {
 	return &Config{
-		Context: context.Background(),
+		Context:          context.Background(),
+		ProgressInterval: defaultProgressInterval,
 	}
 }
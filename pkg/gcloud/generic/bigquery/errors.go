@@ -52,6 +52,14 @@ func (e ErrFailedToImport) Error() string {
 	return fmt.Sprintf("failed to import data to bigquery [%s]", e.Value)
 }
 
+type ErrInvalidFormat struct {
+	Value string
+}
+
+func (e ErrInvalidFormat) Error() string {
+	return fmt.Sprintf("invalid source format, must be one of JSON, CSV, AVRO, PARQUET or ORC [%s]", e.Value)
+}
+
 type ErrFailedToAppend struct {
 	Value string
 }
@@ -91,3 +99,51 @@ type ErrFailedToRead struct {
 func (e ErrFailedToRead) Error() string {
 	return fmt.Sprintf("failed to read data: %s", e.Value)
 }
+
+type ErrFailedToCopy struct {
+	Value string
+}
+
+func (e ErrFailedToCopy) Error() string {
+	return fmt.Sprintf("failed to copy bigquery table [%s]", e.Value)
+}
+
+type ErrInvalidMapping struct {
+	Value string
+}
+
+func (e ErrInvalidMapping) Error() string {
+	return fmt.Sprintf("invalid column mapping: %s", e.Value)
+}
+
+type ErrInvalidIDFunc struct {
+	Value string
+}
+
+func (e ErrInvalidIDFunc) Error() string {
+	return fmt.Sprintf("invalid insert id function: %s", e.Value)
+}
+
+type ErrFailedToDelete struct {
+	Value string
+}
+
+func (e ErrFailedToDelete) Error() string {
+	return fmt.Sprintf("failed to delete data: %s", e.Value)
+}
+
+type ErrInvalidKeyColumns struct {
+	Value string
+}
+
+func (e ErrInvalidKeyColumns) Error() string {
+	return fmt.Sprintf("invalid key columns: %s", e.Value)
+}
+
+type ErrFailedToMerge struct {
+	Value string
+}
+
+func (e ErrFailedToMerge) Error() string {
+	return fmt.Sprintf("failed to merge data into bigquery table [%s]", e.Value)
+}
@@ -1,11 +1,41 @@
 package bigquery
 
 import (
+	"context"
+	"time"
+
 	bq "cloud.google.com/go/bigquery"
 )
 
+// Row is a result row keyed by column name, returned by ExecuteQueryRows.
 type Row map[string]bq.Value
 
+// QueryOptions configures the query job run by ExecuteQueryWithOptions.
+type QueryOptions struct {
+	Labels       map[string]string // Labels are attached to the job, e.g. for billing attribution
+	Priority     bq.QueryPriority  // Priority is bq.InteractivePriority (default) or bq.BatchPriority
+	UseLegacySQL bool              // UseLegacySQL runs the query with legacy SQL instead of standard SQL
+}
+
+// TableMetadata is the storage metadata of a table returned by GetTableMetadata.
+type TableMetadata struct {
+	NumRows      uint64    // NumRows is the number of rows, excluding the streaming buffer
+	NumBytes     int64     // NumBytes is the size of the table in bytes, excluding the streaming buffer
+	LastModified time.Time // LastModified is when the table was last modified
+}
+
+// JobStatus is the progress of a load job reported by ImportJsonFilesWithProgress. The input
+// statistics are known once the job runs, the output ones grow while it runs.
+type JobStatus struct {
+	JobID          string    // JobID is the ID of the load job
+	State          bq.State  // State is bq.Pending, bq.Running or bq.Done
+	StartTime      time.Time // StartTime is when the job started running, zero while pending
+	InputFiles     int64     // InputFiles is the number of source files
+	InputFileBytes int64     // InputFileBytes is the size of the source files in bytes
+	OutputRows     int64     // OutputRows is the number of rows loaded so far
+	OutputBytes    int64     // OutputBytes is the size of the data loaded so far in bytes
+}
+
 type IBigQuery[T any] interface {
 	// AppendMany appends a list of rows to a BigQuery table
 	// Parameters:
@@ -17,6 +47,30 @@ type IBigQuery[T any] interface {
 	//   - error: An error if one occurs.
 	AppendMany(dataSet string, table string, data []T) error
 
+	// AppendManyContext is AppendMany with ctx used for the call instead of the client context
+	// Parameters:
+	//   - ctx: context.Context [The context of the call]
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - data: T [The data to append]
+	//
+	// Returns:
+	//   - error: An error if one occurs.
+	AppendManyContext(ctx context.Context, dataSet string, table string, data []T) error
+
+	// AppendWithDedup appends a list of rows to a BigQuery table with an insert ID per row,
+	// computed by idFunc. BigQuery uses the insert IDs to drop, on a best-effort basis, the
+	// duplicates created when an insert is retried within its deduplication window
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - data: []T [The rows to append, T must be a struct or a pointer to a struct]
+	//   - idFunc: func(T) string [Returns the insert ID of a row]
+	//
+	// Returns:
+	//   - error: ErrInvalidIDFunc if idFunc is nil, or an error if one occurs.
+	AppendWithDedup(dataSet string, table string, data []T, idFunc func(T) string) error
+
 	// Append adds a single row of JSON data to a BigQuery table
 	// Parameters:
 	//   - dataSet: string [The dataset ID]
@@ -27,6 +81,60 @@ type IBigQuery[T any] interface {
 	//   - error: An error if one occurs.
 	Append(dataSet string, table string, data T) error
 
+	// AppendContext is Append with ctx used for the call instead of the client context
+	// Parameters:
+	//   - ctx: context.Context [The context of the call]
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - data: []byte [The JSON data to add]
+	//
+	// Returns:
+	//   - error: An error if one occurs.
+	AppendContext(ctx context.Context, dataSet string, table string, data T) error
+
+	// ImportFiles loads files of any source format supported by load jobs, e.g. Parquet or Avro,
+	// from Cloud Storage to BigQuery
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - gcsFiles: []string [The Cloud Storage files to load]
+	//   - format: bq.DataFormat [The source format: bq.JSON, bq.CSV, bq.Avro, bq.Parquet or bq.ORC]
+	//   - schema: bq.Schema [The schema of the data, nil to read it from Avro, Parquet and ORC files]
+	//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+	//
+	// Returns:
+	//   - error: ErrInvalidFormat if the format can't be loaded from files, or an error if one occurs.
+	ImportFiles(
+		dataSet string,
+		table string,
+		gcsFiles []string,
+		format bq.DataFormat,
+		schema bq.Schema,
+		writeDisposition bq.TableWriteDisposition,
+	) error
+
+	// ImportFilesContext is ImportFiles with ctx used for the load job instead of the client context
+	// Parameters:
+	//   - ctx: context.Context [The context of the call]
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - gcsFiles: []string [The Cloud Storage files to load]
+	//   - format: bq.DataFormat [The source format: bq.JSON, bq.CSV, bq.Avro, bq.Parquet or bq.ORC]
+	//   - schema: bq.Schema [The schema of the data, nil to read it from Avro, Parquet and ORC files]
+	//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+	//
+	// Returns:
+	//   - error: ErrInvalidFormat if the format can't be loaded from files, or an error if one occurs.
+	ImportFilesContext(
+		ctx context.Context,
+		dataSet string,
+		table string,
+		gcsFiles []string,
+		format bq.DataFormat,
+		schema bq.Schema,
+		writeDisposition bq.TableWriteDisposition,
+	) error
+
 	// ImportJsonFile loading newline-delimited JSON data from Cloud Storage to BigQuery
 	// Parameters:
 	//   - dataSet: string [The dataset ID]
@@ -45,6 +153,27 @@ type IBigQuery[T any] interface {
 		writeDisposition bq.TableWriteDisposition,
 	) error
 
+	// ImportJsonFileContext is ImportJsonFile with ctx used for the load job instead of the
+	// client context
+	// Parameters:
+	//   - ctx: context.Context [The context of the call]
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - gcsFile: string [The Cloud Storage file to load]
+	//   - schema: bq.Schema [The schema of the data]
+	//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+	//
+	// Returns:
+	//   - error: An error if one occurs.
+	ImportJsonFileContext(
+		ctx context.Context,
+		dataSet string,
+		table string,
+		gcsFile string,
+		schema bq.Schema,
+		writeDisposition bq.TableWriteDisposition,
+	) error
+
 	// ImportJsonFiles loading newline-delimited JSON data from Cloud Storage to BigQuery
 	// Parameters:
 	//   - dataSet: string [The dataset ID]
@@ -63,6 +192,140 @@ type IBigQuery[T any] interface {
 		writeDisposition bq.TableWriteDisposition,
 	) error
 
+	// ImportJsonFilesContext is ImportJsonFiles with ctx used for the load job instead of the
+	// client context
+	// Parameters:
+	//   - ctx: context.Context [The context of the call]
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - gcsFile: []string [The Cloud Storage files to load]
+	//   - schema: bq.Schema [The schema of the data]
+	//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+	//
+	// Returns:
+	//   - error: An error if one occurs.
+	ImportJsonFilesContext(
+		ctx context.Context,
+		dataSet string,
+		table string,
+		gcsFile []string,
+		schema bq.Schema,
+		writeDisposition bq.TableWriteDisposition,
+	) error
+
+	// ImportJsonFilesWithProgress is ImportJsonFiles reporting the progress of the load job: the
+	// job status is polled every Config.ProgressInterval and passed to progress, until the job is done
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - gcsFiles: []string [The Cloud Storage files to load]
+	//   - schema: bq.Schema [The schema of the data]
+	//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+	//   - progress: func(JobStatus) [Called with every polled status, the last one with State bq.Done]
+	//
+	// Returns:
+	//   - error: An error if one occurs.
+	ImportJsonFilesWithProgress(
+		dataSet string,
+		table string,
+		gcsFiles []string,
+		schema bq.Schema,
+		writeDisposition bq.TableWriteDisposition,
+		progress func(JobStatus),
+	) error
+
+	// CopyTable copies a table into another table using a copy job and waits for it to complete
+	// Parameters:
+	//   - srcDataSet: string [The source dataset ID]
+	//   - srcTable: string [The source table ID]
+	//   - dstDataSet: string [The destination dataset ID]
+	//   - dstTable: string [The destination table ID]
+	//   - writeDisposition: bq.TableWriteDisposition [The write disposition]
+	//
+	// Returns:
+	//   - error: An error if one occurs.
+	CopyTable(
+		srcDataSet string,
+		srcTable string,
+		dstDataSet string,
+		dstTable string,
+		writeDisposition bq.TableWriteDisposition,
+	) error
+
+	// Count counts the rows of a table matching an optional where clause, with values
+	// passed as query parameters referenced from the where clause
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - whereClause: string [The condition rows must match, may be empty]
+	//   - params: ...bq.QueryParameter [The parameters referenced from the where clause]
+	//
+	// Returns:
+	//   - int64: The number of matching rows
+	//   - error: An error if one occurs.
+	Count(dataSet string, table string, whereClause string, params ...bq.QueryParameter) (int64, error)
+
+	// TableExists reports whether a table exists using the table metadata
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//
+	// Returns:
+	//   - bool: true if the table exists
+	//   - error: An error if one occurs.
+	TableExists(dataSet string, table string) (bool, error)
+
+	// DeleteTable deletes a table and its data
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//
+	// Returns:
+	//   - error: ErrInvalidTable if the table does not exist, or an error if one occurs.
+	DeleteTable(dataSet string, table string) error
+
+	// TruncateTable deletes all the rows of a table, keeping its schema
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//
+	// Returns:
+	//   - error: ErrInvalidTable if the table does not exist, or an error if one occurs.
+	TruncateTable(dataSet string, table string) error
+
+	// GetTableSchema retrieves the schema of a table using the table metadata
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//
+	// Returns:
+	//   - bq.Schema: The schema of the table
+	//   - error: ErrInvalidTable if the table does not exist, or an error if one occurs.
+	GetTableSchema(dataSet string, table string) (bq.Schema, error)
+
+	// GetTableMetadata retrieves the row count, size and last modification time of a table
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//
+	// Returns:
+	//   - *TableMetadata: The storage metadata of the table
+	//   - error: ErrInvalidTable if the table does not exist, or an error if one occurs.
+	GetTableMetadata(dataSet string, table string) (*TableMetadata, error)
+
+	// Merge upserts rows into a table through a staging table and a MERGE statement, updating the
+	// rows whose key columns match and inserting the others
+	// Parameters:
+	//   - dataSet: string [The dataset ID]
+	//   - table: string [The table ID]
+	//   - rows: []T [The rows to upsert, T must be a struct or a pointer to a struct]
+	//   - keyColumns: []string [The columns identifying a row]
+	//
+	// Returns:
+	//   - error: ErrInvalidKeyColumns if a key column is not a column of T, ErrInvalidTable if
+	//     the table does not exist, or an error if one occurs.
+	Merge(dataSet string, table string, rows []T, keyColumns []string) error
+
 	// ExecuteQuery executes a BigQuery query and returns the results as a list of rows
 	// Parameters:
 	//   - sql: string [The SQL query]
@@ -71,4 +334,59 @@ type IBigQuery[T any] interface {
 	//   - []T: The results of the query
 	//   - error: An error if one occurs.
 	ExecuteQuery(sql string) ([]T, error)
+
+	// ExecuteQueryContext is ExecuteQuery with ctx used for the query instead of the client
+	// context, e.g. to give a single query a deadline
+	// Parameters:
+	//   - ctx: context.Context [The context of the query]
+	//   - sql: string [The SQL query]
+	//
+	// Returns:
+	//   - []T: The results of the query
+	//   - error: An error if one occurs.
+	ExecuteQueryContext(ctx context.Context, sql string) ([]T, error)
+
+	// ExecuteQueryWithOptions executes a BigQuery query job configured with opts and returns
+	// the results as a list of rows
+	// Parameters:
+	//   - sql: string [The SQL query]
+	//   - opts: QueryOptions [The labels, priority and SQL dialect of the query job]
+	//
+	// Returns:
+	//   - []T: The results of the query
+	//   - error: An error if one occurs.
+	ExecuteQueryWithOptions(sql string, opts QueryOptions) ([]T, error)
+
+	// ExecuteQueryWithOptionsContext is ExecuteQueryWithOptions with ctx used for the query
+	// instead of the client context
+	// Parameters:
+	//   - ctx: context.Context [The context of the query]
+	//   - sql: string [The SQL query]
+	//   - opts: QueryOptions [The labels, priority and SQL dialect of the query job]
+	//
+	// Returns:
+	//   - []T: The results of the query
+	//   - error: An error if one occurs.
+	ExecuteQueryWithOptionsContext(ctx context.Context, sql string, opts QueryOptions) ([]T, error)
+
+	// QueryToStruct executes a BigQuery query and decodes each row into T, mapping columns to
+	// fields through mapping, then the fields' `bq` tags, then the field names
+	// Parameters:
+	//   - sql: string [The SQL query]
+	//   - mapping: map[string]string [The column to field name mapping, may be nil]
+	//
+	// Returns:
+	//   - []T: The results of the query
+	//   - error: An error if one occurs.
+	QueryToStruct(sql string, mapping map[string]string) ([]T, error)
+
+	// ExecuteQueryRows executes a BigQuery query and returns the rows keyed by column name, for
+	// results that don't map to T, e.g. ad-hoc analytics
+	// Parameters:
+	//   - sql: string [The SQL query]
+	//
+	// Returns:
+	//   - []Row: The results of the query
+	//   - error: An error if one occurs.
+	ExecuteQueryRows(sql string) ([]Row, error)
 }
@@ -0,0 +1,125 @@
+package bigquery
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	bq "cloud.google.com/go/bigquery"
+)
+
+// columnFields returns the index of the field of struct type t each column is decoded into,
+// keyed by lower-cased column name. Columns are matched, in order of precedence, through
+// mapping (column name to field name), the field's `bq` tag and the field name.
+func columnFields(t reflect.Type, mapping map[string]string) (map[string]int, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, ErrInvalidMapping{Value: fmt.Sprintf("%s is not a struct", t)}
+	}
+
+	columns := make(map[string]int)
+	tagged := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("bq"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			columns[strings.ToLower(field.Name)] = i
+		default:
+			tagged[strings.ToLower(name)] = i
+		}
+	}
+	for column, i := range tagged {
+		columns[column] = i
+	}
+
+	for column, fieldName := range mapping {
+		field, ok := t.FieldByName(fieldName)
+		if !ok || len(field.Index) != 1 || !field.IsExported() {
+			return nil, ErrInvalidMapping{
+				Value: fmt.Sprintf("column %s is mapped to unknown field %s.%s", column, t.Name(), fieldName),
+			}
+		}
+		columns[strings.ToLower(column)] = field.Index[0]
+	}
+	return columns, nil
+}
+
+// decodeRow stores the columns of row in the struct pointed to by v.
+// Columns without a matching field are ignored.
+func decodeRow(row map[string]bq.Value, v reflect.Value, columns map[string]int) error {
+	for column, value := range row {
+		i, ok := columns[strings.ToLower(column)]
+		if !ok {
+			continue
+		}
+		if err := setValue(v.Field(i), value); err != nil {
+			return ErrFailedToRead{
+				Value: fmt.Sprintf("column %s into field %s: %v", column, v.Type().Field(i).Name, err),
+			}
+		}
+	}
+	return nil
+}
+
+// setValue stores a value read from BigQuery in f, converting between numeric types,
+// allocating pointers and decoding repeated and record columns recursively.
+func setValue(f reflect.Value, value bq.Value) error {
+	if value == nil {
+		return nil
+	}
+
+	if f.Kind() == reflect.Ptr {
+		ptr := reflect.New(f.Type().Elem())
+		if err := setValue(ptr.Elem(), value); err != nil {
+			return err
+		}
+		f.Set(ptr)
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+	switch {
+	case rv.Type().AssignableTo(f.Type()):
+		f.Set(rv)
+	case isNumber(rv.Kind()) && isNumber(f.Kind()):
+		f.Set(rv.Convert(f.Type()))
+	case rv.Kind() == reflect.String && f.Kind() == reflect.String:
+		f.SetString(rv.String())
+	case rv.Kind() == reflect.Slice && f.Kind() == reflect.Slice:
+		s := reflect.MakeSlice(f.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			if err := setValue(s.Index(i), rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		f.Set(s)
+	case f.Kind() == reflect.Struct:
+		record, ok := value.(map[string]bq.Value)
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", value, f.Type())
+		}
+		columns, err := columnFields(f.Type(), nil)
+		if err != nil {
+			return err
+		}
+		return decodeRow(record, f, columns)
+	default:
+		return fmt.Errorf("cannot decode %T into %s", value, f.Type())
+	}
+	return nil
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package bigquery
+
+import (
+	"reflect"
+	"testing"
+
+	bq "cloud.google.com/go/bigquery"
+	"github.com/stretchr/testify/assert"
+)
+
+type mappedAddress struct {
+	City string
+	Zip  string `bq:"zip_code"`
+}
+
+type mappedRow struct {
+	ID      int64 `bq:"user_id"`
+	Name    string
+	Nick    string `bq:"alias"`
+	Alias   string
+	Age     int32
+	Score   float64
+	Email   *string
+	Tags    []string
+	Address mappedAddress
+	Ignored string `bq:"-"`
+}
+
+func TestDecodeRow(t *testing.T) {
+	email := "ada@example.com"
+
+	tests := []struct {
+		name      string
+		mapping   map[string]string
+		row       map[string]bq.Value
+		want      mappedRow
+		errorType error
+	}{
+		{
+			name: "field name matched case-insensitively",
+			row:  map[string]bq.Value{"NAME": "Ada"},
+			want: mappedRow{Name: "Ada"},
+		},
+		{
+			name: "bq tag",
+			row:  map[string]bq.Value{"user_id": int64(7)},
+			want: mappedRow{ID: 7},
+		},
+		{
+			name: "bq tag takes precedence over field name",
+			row:  map[string]bq.Value{"alias": "ada"},
+			want: mappedRow{Nick: "ada"},
+		},
+		{
+			name:    "mapping takes precedence over bq tag",
+			mapping: map[string]string{"alias": "Alias"},
+			row:     map[string]bq.Value{"alias": "ada"},
+			want:    mappedRow{Alias: "ada"},
+		},
+		{
+			name:    "mapping takes precedence over field name",
+			mapping: map[string]string{"name": "Nick"},
+			row:     map[string]bq.Value{"name": "Ada"},
+			want:    mappedRow{Nick: "Ada"},
+		},
+		{
+			name: "numeric conversion",
+			row:  map[string]bq.Value{"age": int64(36), "score": int64(3)},
+			want: mappedRow{Age: 36, Score: 3},
+		},
+		{
+			name: "pointer allocated",
+			row:  map[string]bq.Value{"email": email},
+			want: mappedRow{Email: &email},
+		},
+		{
+			name: "null leaves pointer nil",
+			row:  map[string]bq.Value{"email": nil},
+			want: mappedRow{},
+		},
+		{
+			name: "repeated column",
+			row:  map[string]bq.Value{"tags": []bq.Value{"admin", "ops"}},
+			want: mappedRow{Tags: []string{"admin", "ops"}},
+		},
+		{
+			name: "nested record",
+			row: map[string]bq.Value{
+				"address": map[string]bq.Value{"city": "London", "zip_code": "NW1"},
+			},
+			want: mappedRow{Address: mappedAddress{City: "London", Zip: "NW1"}},
+		},
+		{
+			name: "unknown and ignored columns",
+			row:  map[string]bq.Value{"unknown": "x", "ignored": "x"},
+			want: mappedRow{},
+		},
+		{
+			name:      "mapping to unknown field",
+			mapping:   map[string]string{"alias": "Missing"},
+			row:       map[string]bq.Value{"alias": "ada"},
+			errorType: ErrInvalidMapping{},
+		},
+		{
+			name:      "incompatible value",
+			row:       map[string]bq.Value{"name": int64(1)},
+			errorType: ErrFailedToRead{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got mappedRow
+			columns, err := columnFields(reflect.TypeOf(got), tt.mapping)
+			if err == nil {
+				err = decodeRow(tt.row, reflect.ValueOf(&got).Elem(), columns)
+			}
+			if tt.errorType != nil {
+				assert.IsType(t, tt.errorType, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
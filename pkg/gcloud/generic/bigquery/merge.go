@@ -0,0 +1,181 @@
+package bigquery
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	bq "cloud.google.com/go/bigquery"
+)
+
+// mergeTableExpiration is how long the staging table of a merge is kept when deleting it fails.
+const mergeTableExpiration = time.Hour
+
+// Merge upserts rows into a table: the rows are loaded into a staging table, then a MERGE
+// statement updates the rows of the table whose key columns match a staged row and inserts the
+// others. The columns are derived from the schema inferred from T, so the table must have a
+// column for every field of T. The staging table is created next to the table and deleted
+// afterwards; it expires after an hour if deleting it fails.
+// Parameters:
+//   - dataSet: string [The dataset ID]
+//   - table: string [The table ID]
+//   - rows: []T [The rows to upsert, T must be a struct or a pointer to a struct]
+//   - keyColumns: []string [The columns identifying a row, e.g. the primary key]
+//
+// Returns:
+//   - error: ErrInvalidKeyColumns if a key column is not a column of T, ErrInvalidTable if the
+//     table does not exist, or an error if one occurs.
+func (b *bigQuery[T]) Merge(dataSet string, table string, rows []T, keyColumns []string) error {
+	if dataSet == "" {
+		return ErrInvalidDataset{Value: "dataset ID is required"}
+	}
+	if !identifierRegexp.MatchString(dataSet) {
+		return ErrInvalidDataset{Value: fmt.Sprintf("invalid dataset ID %q", dataSet)}
+	}
+	if table == "" {
+		return ErrInvalidTable{Value: "table ID is required"}
+	}
+	if !identifierRegexp.MatchString(table) {
+		return ErrInvalidTable{Value: fmt.Sprintf("invalid table ID %q", table)}
+	}
+	if len(keyColumns) == 0 {
+		return ErrInvalidKeyColumns{Value: "at least one key column is required"}
+	}
+	var zero T
+	if reflect.TypeOf(zero) == nil {
+		return ErrFailedToMerge{Value: "rows must be structs or pointers to structs"}
+	}
+	schema, err := bq.InferSchema(zero)
+	if err != nil {
+		return ErrFailedToMerge{Value: fmt.Sprintf("failed to infer schema: %v", err)}
+	}
+	keys, err := mergeKeys(schema, keyColumns)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	if _, err := b.tableMetadata(dataSet, table); err != nil {
+		return err
+	}
+
+	staging := fmt.Sprintf("%s_merge_%d", table, time.Now().UnixNano())
+	stagingRef := b.client.Dataset(dataSet).Table(staging)
+	err = stagingRef.Create(b.cfg.Context, &bq.TableMetadata{
+		Schema:         schema,
+		ExpirationTime: time.Now().Add(mergeTableExpiration),
+	})
+	if err != nil {
+		return ErrFailedToMerge{Value: fmt.Sprintf("failed to create staging table: %v", err)}
+	}
+	defer func() {
+		_ = stagingRef.Delete(b.cfg.Context)
+	}()
+
+	if err := b.loadRows(stagingRef, schema, rows); err != nil {
+		return err
+	}
+
+	statement := mergeStatement(dataSet+"."+table, dataSet+"."+staging, schema, keys)
+	job, err := b.client.Query(statement).Run(b.cfg.Context)
+	if err != nil {
+		return ErrFailedToMerge{Value: fmt.Sprintf("failed to start merge job: %v", err)}
+	}
+	status, err := job.Wait(b.cfg.Context)
+	if err != nil {
+		return ErrFailedToMerge{Value: fmt.Sprintf("failed while waiting for merge job: %v", err)}
+	}
+	if status.Err() != nil {
+		return ErrFailedToMerge{Value: fmt.Sprintf("merge job failed: %v", status.Err())}
+	}
+	return nil
+}
+
+// loadRows loads rows into table with a load job rather than streaming inserts, whose rows
+// may not be visible yet to a query run right after creating the table.
+func (b *bigQuery[T]) loadRows(table *bq.Table, schema bq.Schema, rows []T) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, row := range rows {
+		values, _, err := (&bq.StructSaver{Schema: schema, Struct: row}).Save()
+		if err != nil {
+			return ErrFailedToMerge{Value: fmt.Sprintf("failed to encode row: %v", err)}
+		}
+		if err := encoder.Encode(values); err != nil {
+			return ErrFailedToMerge{Value: fmt.Sprintf("failed to encode row: %v", err)}
+		}
+	}
+
+	source := bq.NewReaderSource(&buf)
+	source.SourceFormat = bq.JSON
+	source.Schema = schema
+	loader := table.LoaderFrom(source)
+	loader.WriteDisposition = bq.WriteTruncate
+
+	job, err := loader.Run(b.cfg.Context)
+	if err != nil {
+		return ErrFailedToMerge{Value: fmt.Sprintf("failed to start staging load job: %v", err)}
+	}
+	status, err := job.Wait(b.cfg.Context)
+	if err != nil {
+		return ErrFailedToMerge{Value: fmt.Sprintf("failed while waiting for staging load job: %v", err)}
+	}
+	if status.Err() != nil {
+		return ErrFailedToMerge{Value: fmt.Sprintf("staging load job failed: %v", status.Err())}
+	}
+	return nil
+}
+
+// mergeKeys returns the columns of schema named by keyColumns, which must be distinct.
+func mergeKeys(schema bq.Schema, keyColumns []string) (map[string]bool, error) {
+	keys := make(map[string]bool)
+	for _, key := range keyColumns {
+		column, ok := schemaColumn(schema, key)
+		if !ok {
+			return nil, ErrInvalidKeyColumns{Value: fmt.Sprintf("%q is not a column of the rows", key)}
+		}
+		if keys[column] {
+			return nil, ErrInvalidKeyColumns{Value: fmt.Sprintf("%q is repeated", key)}
+		}
+		keys[column] = true
+	}
+	return keys, nil
+}
+
+// mergeStatement builds the MERGE statement upserting the rows of the source table into the
+// target table, both with schema, matching rows on the key columns.
+func mergeStatement(target string, source string, schema bq.Schema, keys map[string]bool) string {
+	var on, set, columns, values []string
+	for _, field := range schema {
+		columns = append(columns, fmt.Sprintf("`%s`", field.Name))
+		values = append(values, fmt.Sprintf("source.`%s`", field.Name))
+		if keys[field.Name] {
+			on = append(on, fmt.Sprintf("target.`%s` = source.`%s`", field.Name, field.Name))
+		} else {
+			set = append(set, fmt.Sprintf("`%s` = source.`%s`", field.Name, field.Name))
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "MERGE `%s` AS target USING `%s` AS source ON %s", target, source, strings.Join(on, " AND "))
+	if len(set) > 0 {
+		fmt.Fprintf(&sb, " WHEN MATCHED THEN UPDATE SET %s", strings.Join(set, ", "))
+	}
+	fmt.Fprintf(&sb, " WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)", strings.Join(columns, ", "), strings.Join(values, ", "))
+	return sb.String()
+}
+
+// schemaColumn returns the name of the top-level column of schema matching name, compared
+// case-insensitively like BigQuery column names.
+func schemaColumn(schema bq.Schema, name string) (string, bool) {
+	for _, field := range schema {
+		if strings.EqualFold(field.Name, name) {
+			return field.Name, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,69 @@
+package bigquery
+
+import (
+	"testing"
+
+	bq "cloud.google.com/go/bigquery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeStatement(t *testing.T) {
+	schema := bq.Schema{{Name: "id"}, {Name: "Name"}, {Name: "order"}}
+
+	tests := []struct {
+		name       string
+		schema     bq.Schema
+		keyColumns []string
+		want       string
+		errorType  error
+	}{
+		{
+			name:       "single key",
+			schema:     schema,
+			keyColumns: []string{"id"},
+			want: "MERGE `dataset.table` AS target USING `dataset.staging` AS source ON target.`id` = source.`id`" +
+				" WHEN MATCHED THEN UPDATE SET `Name` = source.`Name`, `order` = source.`order`" +
+				" WHEN NOT MATCHED THEN INSERT (`id`, `Name`, `order`) VALUES (source.`id`, source.`Name`, source.`order`)",
+		},
+		{
+			name:       "composite key matched case-insensitively",
+			schema:     schema,
+			keyColumns: []string{"ID", "name"},
+			want: "MERGE `dataset.table` AS target USING `dataset.staging` AS source" +
+				" ON target.`id` = source.`id` AND target.`Name` = source.`Name`" +
+				" WHEN MATCHED THEN UPDATE SET `order` = source.`order`" +
+				" WHEN NOT MATCHED THEN INSERT (`id`, `Name`, `order`) VALUES (source.`id`, source.`Name`, source.`order`)",
+		},
+		{
+			name:       "key only schema",
+			schema:     bq.Schema{{Name: "id"}},
+			keyColumns: []string{"id"},
+			want: "MERGE `dataset.table` AS target USING `dataset.staging` AS source ON target.`id` = source.`id`" +
+				" WHEN NOT MATCHED THEN INSERT (`id`) VALUES (source.`id`)",
+		},
+		{
+			name:       "unknown key column",
+			schema:     schema,
+			keyColumns: []string{"email"},
+			errorType:  ErrInvalidKeyColumns{},
+		},
+		{
+			name:       "repeated key column",
+			schema:     schema,
+			keyColumns: []string{"id", "Id"},
+			errorType:  ErrInvalidKeyColumns{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, err := mergeKeys(tt.schema, tt.keyColumns)
+			if tt.errorType != nil {
+				assert.IsType(t, tt.errorType, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, mergeStatement("dataset.table", "dataset.staging", tt.schema, keys))
+		})
+	}
+}
@@ -10,9 +10,11 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	bigquery "cloud.google.com/go/bigquery"
+	bigquery0 "github.com/pal-paul/go-libraries/pkg/gcloud/generic/bigquery"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -54,6 +56,20 @@ func (mr *MockIBigQueryMockRecorder[T]) Append(dataSet, table, data any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockIBigQuery[T])(nil).Append), dataSet, table, data)
 }
 
+// AppendContext mocks base method.
+func (m *MockIBigQuery[T]) AppendContext(ctx context.Context, dataSet, table string, data T) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendContext", ctx, dataSet, table, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendContext indicates an expected call of AppendContext.
+func (mr *MockIBigQueryMockRecorder[T]) AppendContext(ctx, dataSet, table, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendContext", reflect.TypeOf((*MockIBigQuery[T])(nil).AppendContext), ctx, dataSet, table, data)
+}
+
 // AppendMany mocks base method.
 func (m *MockIBigQuery[T]) AppendMany(dataSet, table string, data []T) error {
 	m.ctrl.T.Helper()
@@ -68,6 +84,82 @@ func (mr *MockIBigQueryMockRecorder[T]) AppendMany(dataSet, table, data any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendMany", reflect.TypeOf((*MockIBigQuery[T])(nil).AppendMany), dataSet, table, data)
 }
 
+// AppendManyContext mocks base method.
+func (m *MockIBigQuery[T]) AppendManyContext(ctx context.Context, dataSet, table string, data []T) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendManyContext", ctx, dataSet, table, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendManyContext indicates an expected call of AppendManyContext.
+func (mr *MockIBigQueryMockRecorder[T]) AppendManyContext(ctx, dataSet, table, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendManyContext", reflect.TypeOf((*MockIBigQuery[T])(nil).AppendManyContext), ctx, dataSet, table, data)
+}
+
+// AppendWithDedup mocks base method.
+func (m *MockIBigQuery[T]) AppendWithDedup(dataSet, table string, data []T, idFunc func(T) string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendWithDedup", dataSet, table, data, idFunc)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendWithDedup indicates an expected call of AppendWithDedup.
+func (mr *MockIBigQueryMockRecorder[T]) AppendWithDedup(dataSet, table, data, idFunc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendWithDedup", reflect.TypeOf((*MockIBigQuery[T])(nil).AppendWithDedup), dataSet, table, data, idFunc)
+}
+
+// CopyTable mocks base method.
+func (m *MockIBigQuery[T]) CopyTable(srcDataSet, srcTable, dstDataSet, dstTable string, writeDisposition bigquery.TableWriteDisposition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTable", srcDataSet, srcTable, dstDataSet, dstTable, writeDisposition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyTable indicates an expected call of CopyTable.
+func (mr *MockIBigQueryMockRecorder[T]) CopyTable(srcDataSet, srcTable, dstDataSet, dstTable, writeDisposition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTable", reflect.TypeOf((*MockIBigQuery[T])(nil).CopyTable), srcDataSet, srcTable, dstDataSet, dstTable, writeDisposition)
+}
+
+// Count mocks base method.
+func (m *MockIBigQuery[T]) Count(dataSet, table, whereClause string, params ...bigquery.QueryParameter) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{dataSet, table, whereClause}
+	for _, a := range params {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Count", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockIBigQueryMockRecorder[T]) Count(dataSet, table, whereClause any, params ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{dataSet, table, whereClause}, params...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockIBigQuery[T])(nil).Count), varargs...)
+}
+
+// DeleteTable mocks base method.
+func (m *MockIBigQuery[T]) DeleteTable(dataSet, table string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTable", dataSet, table)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTable indicates an expected call of DeleteTable.
+func (mr *MockIBigQueryMockRecorder[T]) DeleteTable(dataSet, table any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTable", reflect.TypeOf((*MockIBigQuery[T])(nil).DeleteTable), dataSet, table)
+}
+
 // ExecuteQuery mocks base method.
 func (m *MockIBigQuery[T]) ExecuteQuery(sql string) ([]T, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +175,124 @@ func (mr *MockIBigQueryMockRecorder[T]) ExecuteQuery(sql any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteQuery", reflect.TypeOf((*MockIBigQuery[T])(nil).ExecuteQuery), sql)
 }
 
+// ExecuteQueryContext mocks base method.
+func (m *MockIBigQuery[T]) ExecuteQueryContext(ctx context.Context, sql string) ([]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteQueryContext", ctx, sql)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteQueryContext indicates an expected call of ExecuteQueryContext.
+func (mr *MockIBigQueryMockRecorder[T]) ExecuteQueryContext(ctx, sql any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteQueryContext", reflect.TypeOf((*MockIBigQuery[T])(nil).ExecuteQueryContext), ctx, sql)
+}
+
+// ExecuteQueryRows mocks base method.
+func (m *MockIBigQuery[T]) ExecuteQueryRows(sql string) ([]bigquery0.Row, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteQueryRows", sql)
+	ret0, _ := ret[0].([]bigquery0.Row)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteQueryRows indicates an expected call of ExecuteQueryRows.
+func (mr *MockIBigQueryMockRecorder[T]) ExecuteQueryRows(sql any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteQueryRows", reflect.TypeOf((*MockIBigQuery[T])(nil).ExecuteQueryRows), sql)
+}
+
+// ExecuteQueryWithOptions mocks base method.
+func (m *MockIBigQuery[T]) ExecuteQueryWithOptions(sql string, opts bigquery0.QueryOptions) ([]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteQueryWithOptions", sql, opts)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteQueryWithOptions indicates an expected call of ExecuteQueryWithOptions.
+func (mr *MockIBigQueryMockRecorder[T]) ExecuteQueryWithOptions(sql, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteQueryWithOptions", reflect.TypeOf((*MockIBigQuery[T])(nil).ExecuteQueryWithOptions), sql, opts)
+}
+
+// ExecuteQueryWithOptionsContext mocks base method.
+func (m *MockIBigQuery[T]) ExecuteQueryWithOptionsContext(ctx context.Context, sql string, opts bigquery0.QueryOptions) ([]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteQueryWithOptionsContext", ctx, sql, opts)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteQueryWithOptionsContext indicates an expected call of ExecuteQueryWithOptionsContext.
+func (mr *MockIBigQueryMockRecorder[T]) ExecuteQueryWithOptionsContext(ctx, sql, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteQueryWithOptionsContext", reflect.TypeOf((*MockIBigQuery[T])(nil).ExecuteQueryWithOptionsContext), ctx, sql, opts)
+}
+
+// GetTableMetadata mocks base method.
+func (m *MockIBigQuery[T]) GetTableMetadata(dataSet, table string) (*bigquery0.TableMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableMetadata", dataSet, table)
+	ret0, _ := ret[0].(*bigquery0.TableMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableMetadata indicates an expected call of GetTableMetadata.
+func (mr *MockIBigQueryMockRecorder[T]) GetTableMetadata(dataSet, table any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableMetadata", reflect.TypeOf((*MockIBigQuery[T])(nil).GetTableMetadata), dataSet, table)
+}
+
+// GetTableSchema mocks base method.
+func (m *MockIBigQuery[T]) GetTableSchema(dataSet, table string) (bigquery.Schema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableSchema", dataSet, table)
+	ret0, _ := ret[0].(bigquery.Schema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableSchema indicates an expected call of GetTableSchema.
+func (mr *MockIBigQueryMockRecorder[T]) GetTableSchema(dataSet, table any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableSchema", reflect.TypeOf((*MockIBigQuery[T])(nil).GetTableSchema), dataSet, table)
+}
+
+// ImportFiles mocks base method.
+func (m *MockIBigQuery[T]) ImportFiles(dataSet, table string, gcsFiles []string, format bigquery.DataFormat, schema bigquery.Schema, writeDisposition bigquery.TableWriteDisposition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportFiles", dataSet, table, gcsFiles, format, schema, writeDisposition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportFiles indicates an expected call of ImportFiles.
+func (mr *MockIBigQueryMockRecorder[T]) ImportFiles(dataSet, table, gcsFiles, format, schema, writeDisposition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportFiles", reflect.TypeOf((*MockIBigQuery[T])(nil).ImportFiles), dataSet, table, gcsFiles, format, schema, writeDisposition)
+}
+
+// ImportFilesContext mocks base method.
+func (m *MockIBigQuery[T]) ImportFilesContext(ctx context.Context, dataSet, table string, gcsFiles []string, format bigquery.DataFormat, schema bigquery.Schema, writeDisposition bigquery.TableWriteDisposition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportFilesContext", ctx, dataSet, table, gcsFiles, format, schema, writeDisposition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportFilesContext indicates an expected call of ImportFilesContext.
+func (mr *MockIBigQueryMockRecorder[T]) ImportFilesContext(ctx, dataSet, table, gcsFiles, format, schema, writeDisposition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportFilesContext", reflect.TypeOf((*MockIBigQuery[T])(nil).ImportFilesContext), ctx, dataSet, table, gcsFiles, format, schema, writeDisposition)
+}
+
 // ImportJsonFile mocks base method.
 func (m *MockIBigQuery[T]) ImportJsonFile(dataSet, table, gcsFile string, schema bigquery.Schema, writeDisposition bigquery.TableWriteDisposition) error {
 	m.ctrl.T.Helper()
@@ -97,6 +307,20 @@ func (mr *MockIBigQueryMockRecorder[T]) ImportJsonFile(dataSet, table, gcsFile,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJsonFile", reflect.TypeOf((*MockIBigQuery[T])(nil).ImportJsonFile), dataSet, table, gcsFile, schema, writeDisposition)
 }
 
+// ImportJsonFileContext mocks base method.
+func (m *MockIBigQuery[T]) ImportJsonFileContext(ctx context.Context, dataSet, table, gcsFile string, schema bigquery.Schema, writeDisposition bigquery.TableWriteDisposition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJsonFileContext", ctx, dataSet, table, gcsFile, schema, writeDisposition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportJsonFileContext indicates an expected call of ImportJsonFileContext.
+func (mr *MockIBigQueryMockRecorder[T]) ImportJsonFileContext(ctx, dataSet, table, gcsFile, schema, writeDisposition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJsonFileContext", reflect.TypeOf((*MockIBigQuery[T])(nil).ImportJsonFileContext), ctx, dataSet, table, gcsFile, schema, writeDisposition)
+}
+
 // ImportJsonFiles mocks base method.
 func (m *MockIBigQuery[T]) ImportJsonFiles(dataSet, table string, gcsFile []string, schema bigquery.Schema, writeDisposition bigquery.TableWriteDisposition) error {
 	m.ctrl.T.Helper()
@@ -110,3 +334,89 @@ func (mr *MockIBigQueryMockRecorder[T]) ImportJsonFiles(dataSet, table, gcsFile,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJsonFiles", reflect.TypeOf((*MockIBigQuery[T])(nil).ImportJsonFiles), dataSet, table, gcsFile, schema, writeDisposition)
 }
+
+// ImportJsonFilesContext mocks base method.
+func (m *MockIBigQuery[T]) ImportJsonFilesContext(ctx context.Context, dataSet, table string, gcsFile []string, schema bigquery.Schema, writeDisposition bigquery.TableWriteDisposition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJsonFilesContext", ctx, dataSet, table, gcsFile, schema, writeDisposition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportJsonFilesContext indicates an expected call of ImportJsonFilesContext.
+func (mr *MockIBigQueryMockRecorder[T]) ImportJsonFilesContext(ctx, dataSet, table, gcsFile, schema, writeDisposition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJsonFilesContext", reflect.TypeOf((*MockIBigQuery[T])(nil).ImportJsonFilesContext), ctx, dataSet, table, gcsFile, schema, writeDisposition)
+}
+
+// ImportJsonFilesWithProgress mocks base method.
+func (m *MockIBigQuery[T]) ImportJsonFilesWithProgress(dataSet, table string, gcsFiles []string, schema bigquery.Schema, writeDisposition bigquery.TableWriteDisposition, progress func(bigquery0.JobStatus)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportJsonFilesWithProgress", dataSet, table, gcsFiles, schema, writeDisposition, progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportJsonFilesWithProgress indicates an expected call of ImportJsonFilesWithProgress.
+func (mr *MockIBigQueryMockRecorder[T]) ImportJsonFilesWithProgress(dataSet, table, gcsFiles, schema, writeDisposition, progress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportJsonFilesWithProgress", reflect.TypeOf((*MockIBigQuery[T])(nil).ImportJsonFilesWithProgress), dataSet, table, gcsFiles, schema, writeDisposition, progress)
+}
+
+// Merge mocks base method.
+func (m *MockIBigQuery[T]) Merge(dataSet, table string, rows []T, keyColumns []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", dataSet, table, rows, keyColumns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockIBigQueryMockRecorder[T]) Merge(dataSet, table, rows, keyColumns any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockIBigQuery[T])(nil).Merge), dataSet, table, rows, keyColumns)
+}
+
+// QueryToStruct mocks base method.
+func (m *MockIBigQuery[T]) QueryToStruct(sql string, mapping map[string]string) ([]T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryToStruct", sql, mapping)
+	ret0, _ := ret[0].([]T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryToStruct indicates an expected call of QueryToStruct.
+func (mr *MockIBigQueryMockRecorder[T]) QueryToStruct(sql, mapping any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryToStruct", reflect.TypeOf((*MockIBigQuery[T])(nil).QueryToStruct), sql, mapping)
+}
+
+// TableExists mocks base method.
+func (m *MockIBigQuery[T]) TableExists(dataSet, table string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TableExists", dataSet, table)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TableExists indicates an expected call of TableExists.
+func (mr *MockIBigQueryMockRecorder[T]) TableExists(dataSet, table any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TableExists", reflect.TypeOf((*MockIBigQuery[T])(nil).TableExists), dataSet, table)
+}
+
+// TruncateTable mocks base method.
+func (m *MockIBigQuery[T]) TruncateTable(dataSet, table string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateTable", dataSet, table)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TruncateTable indicates an expected call of TruncateTable.
+func (mr *MockIBigQueryMockRecorder[T]) TruncateTable(dataSet, table any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateTable", reflect.TypeOf((*MockIBigQuery[T])(nil).TruncateTable), dataSet, table)
+}
@@ -25,7 +25,7 @@ type ErrInvalidSecretName struct {
 }
 
 func (e ErrInvalidSecretName) Error() string {
-	return fmt.Sprintf("invalid secret name, secret name can't be blank [%s]", e.Value)
+	return fmt.Sprintf("invalid secret name [%s]", e.Value)
 }
 
 type ErrInvalidSecretVersion struct {
@@ -67,3 +67,27 @@ type ErrInvalidSecretData struct {
 func (e ErrInvalidSecretData) Error() string {
 	return fmt.Sprintf("invalid secret data [%s]", e.Value)
 }
+
+type ErrInvalidMember struct {
+	Value string
+}
+
+func (e ErrInvalidMember) Error() string {
+	return fmt.Sprintf("invalid iam member, must be allUsers, allAuthenticatedUsers or prefixed with a principal type such as serviceAccount: [%s]", e.Value)
+}
+
+type ErrInvalidRole struct {
+	Value string
+}
+
+func (e ErrInvalidRole) Error() string {
+	return fmt.Sprintf("invalid iam role, must be roles/<name> or a custom role name [%s]", e.Value)
+}
+
+type ErrFailedToUpdatePolicy struct {
+	Value string
+}
+
+func (e ErrFailedToUpdatePolicy) Error() string {
+	return fmt.Sprintf("failed to update iam policy [%s]", e.Value)
+}
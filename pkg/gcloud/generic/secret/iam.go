@@ -0,0 +1,201 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPolicyUpdateAttempts is how many times a policy update is attempted when it conflicts
+// with a concurrent update of the same policy.
+const maxPolicyUpdateAttempts = 5
+
+// policyUpdateBackoff is the wait before the first retry of a conflicting policy update,
+// doubled for every retry.
+const policyUpdateBackoff = 100 * time.Millisecond
+
+// policyVersion is the IAM policy version read and written, so that conditional bindings
+// are preserved instead of the policy being rejected or downgraded.
+const policyVersion = 3
+
+// memberPrefixes are the principal types accepted in an IAM binding, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com.
+var memberPrefixes = []string{"user:", "serviceAccount:", "group:", "domain:", "principal:", "principalSet:"}
+
+// validateMember checks that member is an IAM principal identifier.
+func validateMember(member string) error {
+	if member == "allUsers" || member == "allAuthenticatedUsers" {
+		return nil
+	}
+	for _, prefix := range memberPrefixes {
+		if strings.HasPrefix(member, prefix) && len(member) > len(prefix) {
+			return nil
+		}
+	}
+	return ErrInvalidMember{Value: member}
+}
+
+// validateRole checks that role is a predefined or custom IAM role name.
+func validateRole(role string) error {
+	if strings.HasPrefix(role, "roles/") && len(role) > len("roles/") {
+		return nil
+	}
+	if (strings.HasPrefix(role, "projects/") || strings.HasPrefix(role, "organizations/")) &&
+		strings.Contains(role, "/roles/") && !strings.HasSuffix(role, "/roles/") {
+		return nil
+	}
+	return ErrInvalidRole{Value: role}
+}
+
+// AddIAMBinding grants role to member on a secret
+// Parameters:
+//   - secretName: string [The secret name]
+//   - member: string [The principal, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com]
+//   - role: string [The role, e.g. roles/secretmanager.secretAccessor]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (s *secret[T]) AddIAMBinding(secretName string, member string, role string) error {
+	return s.AddIAMBindingWithContext(s.conf.Context, secretName, member, role)
+}
+
+// AddIAMBindingWithContext grants role to member on a secret using ctx for the calls
+// Parameters:
+//   - ctx: context.Context [The context of the calls]
+//   - secretName: string [The secret name]
+//   - member: string [The principal, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com]
+//   - role: string [The role, e.g. roles/secretmanager.secretAccessor]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (s *secret[T]) AddIAMBindingWithContext(ctx context.Context, secretName string, member string, role string) error {
+	return s.updatePolicy(ctx, secretName, member, role, func(policy *iampb.Policy) bool {
+		for _, binding := range policy.GetBindings() {
+			if binding.GetRole() != role || binding.GetCondition() != nil {
+				continue
+			}
+			if slices.Contains(binding.GetMembers(), member) {
+				return false
+			}
+			binding.Members = append(binding.Members, member)
+			return true
+		}
+		policy.Bindings = append(policy.Bindings, &iampb.Binding{
+			Role:    role,
+			Members: []string{member},
+		})
+		return true
+	})
+}
+
+// RemoveIAMBinding revokes role from member on a secret
+// Parameters:
+//   - secretName: string [The secret name]
+//   - member: string [The principal, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com]
+//   - role: string [The role, e.g. roles/secretmanager.secretAccessor]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (s *secret[T]) RemoveIAMBinding(secretName string, member string, role string) error {
+	return s.RemoveIAMBindingWithContext(s.conf.Context, secretName, member, role)
+}
+
+// RemoveIAMBindingWithContext revokes role from member on a secret using ctx for the calls
+// Parameters:
+//   - ctx: context.Context [The context of the calls]
+//   - secretName: string [The secret name]
+//   - member: string [The principal, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com]
+//   - role: string [The role, e.g. roles/secretmanager.secretAccessor]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (s *secret[T]) RemoveIAMBindingWithContext(ctx context.Context, secretName string, member string, role string) error {
+	return s.updatePolicy(ctx, secretName, member, role, func(policy *iampb.Policy) bool {
+		changed := false
+		bindings := policy.GetBindings()[:0]
+		for _, binding := range policy.GetBindings() {
+			if binding.GetRole() == role && binding.GetCondition() == nil {
+				if i := slices.Index(binding.GetMembers(), member); i >= 0 {
+					binding.Members = slices.Delete(binding.Members, i, i+1)
+					changed = true
+				}
+				if len(binding.GetMembers()) == 0 {
+					continue
+				}
+			}
+			bindings = append(bindings, binding)
+		}
+		policy.Bindings = bindings
+		return changed
+	})
+}
+
+// updatePolicy validates the binding, then reads the IAM policy of the secret, applies modify
+// and writes the policy back when modify reports a change, using ctx for the calls. The policy
+// etag makes the write fail with ABORTED when the policy was changed concurrently, in which case
+// the whole read-modify-write is attempted again after a short backoff.
+func (s *secret[T]) updatePolicy(
+	ctx context.Context,
+	secretName string,
+	member string,
+	role string,
+	modify func(policy *iampb.Policy) bool,
+) error {
+	if err := validateSecretName(secretName); err != nil {
+		return err
+	}
+	if err := validateMember(member); err != nil {
+		return err
+	}
+	if err := validateRole(role); err != nil {
+		return err
+	}
+	if s.conf.ProjectId == "" {
+		return ErrProjectIdBlank{Value: "project ID is required"}
+	}
+	if s.client == nil {
+		return ErrFailedToCreateClient{Value: "secret manager client is not initialized"}
+	}
+
+	resource := "projects/" + s.conf.ProjectId + "/secrets/" + secretName
+	var err error
+	backoff := policyUpdateBackoff
+	for attempt := 0; attempt < maxPolicyUpdateAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ErrFailedToUpdatePolicy{Value: fmt.Sprintf("failed to set iam policy: %v", ctx.Err())}
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+		var policy *iampb.Policy
+		policy, err = s.client.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{
+			Resource: resource,
+			Options:  &iampb.GetPolicyOptions{RequestedPolicyVersion: policyVersion},
+		})
+		if err != nil {
+			return ErrFailedToUpdatePolicy{Value: fmt.Sprintf("failed to get iam policy: %v", err)}
+		}
+		if !modify(policy) {
+			return nil
+		}
+		policy.Version = policyVersion
+		_, err = s.client.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
+			Resource: resource,
+			Policy:   policy,
+		})
+		if err == nil {
+			return nil
+		}
+		if status.Code(err) != codes.Aborted {
+			break
+		}
+	}
+	return ErrFailedToUpdatePolicy{Value: fmt.Sprintf("failed to set iam policy: %v", err)}
+}
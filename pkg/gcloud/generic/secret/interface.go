@@ -12,6 +12,7 @@ package secret
 
 //go:generate mockgen -source=interface.go -destination=mocks/mock-secret.go -package=mocks
 import (
+	"context"
 	"regexp"
 
 	sm "cloud.google.com/go/secretmanager/apiv1"
@@ -44,6 +45,18 @@ type ISecret[T any] interface {
 	//   - ErrFailedToCreateClient: If the client is not initialized
 	GetBytes(name string) ([]byte, error)
 
+	// GetBytesWithContext is GetBytes using ctx for the call instead of the client context,
+	// e.g. to apply the deadline of a request handler.
+	//
+	// Parameters:
+	//   - ctx: The context of the call
+	//   - name: The name of the secret to retrieve
+	//
+	// Returns:
+	//   - []byte: The secret data
+	//   - error: An error if the operation fails, of the same types as GetBytes
+	GetBytesWithContext(ctx context.Context, name string) ([]byte, error)
+
 	// Get retrieves and unmarshals a secret's latest version into type T.
 	// This method provides type-safe secret retrieval by automatically unmarshaling
 	// the secret data into the specified type.
@@ -61,6 +74,17 @@ type ISecret[T any] interface {
 	//   - json.UnmarshalError: If the secret data cannot be unmarshaled into type T
 	Get(name string) (T, error)
 
+	// GetWithContext is Get using ctx for the call instead of the client context.
+	//
+	// Parameters:
+	//   - ctx: The context of the call
+	//   - name: The name of the secret to retrieve
+	//
+	// Returns:
+	//   - T: The unmarshaled secret data
+	//   - error: An error if the operation fails, of the same types as Get
+	GetWithContext(ctx context.Context, name string) (T, error)
+
 	// GetVersion retrieves a specific version of a secret as raw bytes.
 	// This method allows access to historical versions of secrets when needed.
 	//
@@ -78,6 +102,18 @@ type ISecret[T any] interface {
 	//   - ErrFailedToCreateClient: If the client is not initialized
 	GetVersion(name string, version string) ([]byte, error)
 
+	// GetVersionWithContext is GetVersion using ctx for the call instead of the client context.
+	//
+	// Parameters:
+	//   - ctx: The context of the call
+	//   - name: The name of the secret to retrieve
+	//   - version: The version identifier (e.g., "1", "2", "latest")
+	//
+	// Returns:
+	//   - []byte: The secret data
+	//   - error: An error if the operation fails, of the same types as GetVersion
+	GetVersionWithContext(ctx context.Context, name string, version string) ([]byte, error)
+
 	// GetSecrets retrieves all secrets matching a regular expression pattern.
 	// This method is useful for retrieving groups of related secrets.
 	//
@@ -94,6 +130,18 @@ type ISecret[T any] interface {
 	//   - ErrFailedToListSecrets: If listing secrets fails
 	GetSecrets(secretsRegexp *regexp.Regexp) ([]SecretData, error)
 
+	// GetSecretsWithContext is GetSecrets using ctx for the listing and the access of each
+	// secret instead of the client context.
+	//
+	// Parameters:
+	//   - ctx: The context of the calls
+	//   - secretsRegexp: A regular expression pattern to match secret names
+	//
+	// Returns:
+	//   - []SecretData: A list of matching secrets with their data
+	//   - error: An error if the operation fails, of the same types as GetSecrets
+	GetSecretsWithContext(ctx context.Context, secretsRegexp *regexp.Regexp) ([]SecretData, error)
+
 	// CreateSecret creates a new secret in Secret Manager.
 	// The secret is created with automatic replication by default.
 	//
@@ -109,6 +157,16 @@ type ISecret[T any] interface {
 	//   - ErrFailedToCreateSecret: If secret creation fails
 	CreateSecret(secretName string) error
 
+	// CreateSecretWithContext is CreateSecret using ctx for the call instead of the client context.
+	//
+	// Parameters:
+	//   - ctx: The context of the call
+	//   - secretName: The name for the new secret
+	//
+	// Returns:
+	//   - error: An error if the operation fails, of the same types as CreateSecret
+	CreateSecretWithContext(ctx context.Context, secretName string) error
+
 	// AddSecretVersion adds a new version to an existing secret.
 	//
 	// Parameters:
@@ -122,5 +180,136 @@ type ISecret[T any] interface {
 	//   - ErrInvalidSecretName: If the name is empty
 	//   - ErrInvalidSecretData: If the payload is nil or empty
 	//   - ErrFailedToCreateClient: If the client is not initialized
+	//
+	// Deprecated: use AddSecretVersionWithResult, which also returns the created version.
 	AddSecretVersion(secretName string, payload []byte) error
+
+	// AddSecretVersionWithResult adds a new version to an existing secret and returns
+	// the resource name of the created version, which can be used to pin to it later.
+	//
+	// Parameters:
+	//   - secretName: The name of the secret to version
+	//   - payload: The secret data to store
+	//
+	// Returns:
+	//   - string: The version resource name, e.g. projects/my-project/secrets/my-secret/versions/3
+	//   - error: An error if the operation fails
+	//
+	// The error will be of type:
+	//   - ErrInvalidSecretName: If the name is empty
+	//   - ErrInvalidSecretData: If the payload is nil or empty
+	//   - ErrFailedToCreateClient: If the client is not initialized
+	//   - ErrFailedToCreateSecret: If adding the version fails
+	AddSecretVersionWithResult(secretName string, payload []byte) (string, error)
+
+	// AddSecretVersionWithResultWithContext is AddSecretVersionWithResult using ctx for the call
+	// instead of the client context.
+	//
+	// Parameters:
+	//   - ctx: The context of the call
+	//   - secretName: The name of the secret to version
+	//   - payload: The secret data to store
+	//
+	// Returns:
+	//   - string: The version resource name, e.g. projects/my-project/secrets/my-secret/versions/3
+	//   - error: An error if the operation fails, of the same types as AddSecretVersionWithResult
+	AddSecretVersionWithResultWithContext(ctx context.Context, secretName string, payload []byte) (string, error)
+
+	// UpsertSecretVersion creates the secret if it does not exist yet and adds a new version to it.
+	// An AlreadyExists error from creating the secret is ignored.
+	//
+	// Parameters:
+	//   - secretName: The name of the secret to create or version
+	//   - payload: The secret data to store
+	//
+	// Returns:
+	//   - error: An error if the operation fails
+	//
+	// The error will be of type:
+	//   - ErrInvalidSecretName: If the name is empty
+	//   - ErrInvalidSecretData: If the payload is nil or empty
+	//   - ErrProjectIdBlank: If the project ID is not set
+	//   - ErrFailedToCreateClient: If the client is not initialized
+	//   - ErrFailedToCreateSecret: If secret creation fails for any reason other than AlreadyExists
+	UpsertSecretVersion(secretName string, payload []byte) error
+
+	// UpsertSecretVersionWithContext is UpsertSecretVersion using ctx for the calls instead of
+	// the client context.
+	//
+	// Parameters:
+	//   - ctx: The context of the calls
+	//   - secretName: The name of the secret to create or version
+	//   - payload: The secret data to store
+	//
+	// Returns:
+	//   - error: An error if the operation fails, of the same types as UpsertSecretVersion
+	UpsertSecretVersionWithContext(ctx context.Context, secretName string, payload []byte) error
+
+	// AddIAMBinding grants a role on a secret to a member, e.g. to let a service account
+	// access the secret. The secret IAM policy is read, modified and written back, and the
+	// update is retried when it conflicts with a concurrent policy update.
+	// Adding a binding that already exists does nothing.
+	//
+	// Parameters:
+	//   - secretName: The name of the secret
+	//   - member: The principal, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com
+	//   - role: The role, e.g. roles/secretmanager.secretAccessor
+	//
+	// Returns:
+	//   - error: An error if the operation fails
+	//
+	// The error will be of type:
+	//   - ErrInvalidSecretName: If the name is invalid
+	//   - ErrInvalidMember: If the member is not a principal identifier
+	//   - ErrInvalidRole: If the role is not a role name
+	//   - ErrProjectIdBlank: If the project ID is not set
+	//   - ErrFailedToCreateClient: If the client is not initialized
+	//   - ErrFailedToUpdatePolicy: If reading or writing the policy fails
+	AddIAMBinding(secretName string, member string, role string) error
+
+	// AddIAMBindingWithContext is AddIAMBinding using ctx for the calls, including the waits
+	// between retries, instead of the client context.
+	//
+	// Parameters:
+	//   - ctx: The context of the calls
+	//   - secretName: The name of the secret
+	//   - member: The principal, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com
+	//   - role: The role, e.g. roles/secretmanager.secretAccessor
+	//
+	// Returns:
+	//   - error: An error if the operation fails, of the same types as AddIAMBinding
+	AddIAMBindingWithContext(ctx context.Context, secretName string, member string, role string) error
+
+	// RemoveIAMBinding revokes a role on a secret from a member, like AddIAMBinding does for
+	// granting it. Removing a binding that does not exist does nothing.
+	//
+	// Parameters:
+	//   - secretName: The name of the secret
+	//   - member: The principal, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com
+	//   - role: The role, e.g. roles/secretmanager.secretAccessor
+	//
+	// Returns:
+	//   - error: An error if the operation fails
+	//
+	// The error will be of type:
+	//   - ErrInvalidSecretName: If the name is invalid
+	//   - ErrInvalidMember: If the member is not a principal identifier
+	//   - ErrInvalidRole: If the role is not a role name
+	//   - ErrProjectIdBlank: If the project ID is not set
+	//   - ErrFailedToCreateClient: If the client is not initialized
+	//   - ErrFailedToUpdatePolicy: If reading or writing the policy fails
+	RemoveIAMBinding(secretName string, member string, role string) error
+
+	// RemoveIAMBindingWithContext is RemoveIAMBinding using ctx for the calls, including the
+	// waits between retries, instead of the client context.
+	//
+	// Parameters:
+	//   - ctx: The context of the calls
+	//   - secretName: The name of the secret
+	//   - member: The principal, e.g. serviceAccount:app@my-project.iam.gserviceaccount.com
+	//   - role: The role, e.g. roles/secretmanager.secretAccessor
+	//
+	// Returns:
+	//   - error: An error if the operation fails, of the same types as RemoveIAMBinding
+	RemoveIAMBindingWithContext(ctx context.Context, secretName string, member string, role string) error
 }
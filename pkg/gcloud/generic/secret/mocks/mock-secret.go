@@ -10,6 +10,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 	regexp "regexp"
 
@@ -41,6 +42,34 @@ func (m *MockISecret[T]) EXPECT() *MockISecretMockRecorder[T] {
 	return m.recorder
 }
 
+// AddIAMBinding mocks base method.
+func (m *MockISecret[T]) AddIAMBinding(secretName, member, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIAMBinding", secretName, member, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddIAMBinding indicates an expected call of AddIAMBinding.
+func (mr *MockISecretMockRecorder[T]) AddIAMBinding(secretName, member, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIAMBinding", reflect.TypeOf((*MockISecret[T])(nil).AddIAMBinding), secretName, member, role)
+}
+
+// AddIAMBindingWithContext mocks base method.
+func (m *MockISecret[T]) AddIAMBindingWithContext(ctx context.Context, secretName, member, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIAMBindingWithContext", ctx, secretName, member, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddIAMBindingWithContext indicates an expected call of AddIAMBindingWithContext.
+func (mr *MockISecretMockRecorder[T]) AddIAMBindingWithContext(ctx, secretName, member, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIAMBindingWithContext", reflect.TypeOf((*MockISecret[T])(nil).AddIAMBindingWithContext), ctx, secretName, member, role)
+}
+
 // AddSecretVersion mocks base method.
 func (m *MockISecret[T]) AddSecretVersion(secretName string, payload []byte) error {
 	m.ctrl.T.Helper()
@@ -55,6 +84,36 @@ func (mr *MockISecretMockRecorder[T]) AddSecretVersion(secretName, payload any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretVersion", reflect.TypeOf((*MockISecret[T])(nil).AddSecretVersion), secretName, payload)
 }
 
+// AddSecretVersionWithResult mocks base method.
+func (m *MockISecret[T]) AddSecretVersionWithResult(secretName string, payload []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecretVersionWithResult", secretName, payload)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSecretVersionWithResult indicates an expected call of AddSecretVersionWithResult.
+func (mr *MockISecretMockRecorder[T]) AddSecretVersionWithResult(secretName, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretVersionWithResult", reflect.TypeOf((*MockISecret[T])(nil).AddSecretVersionWithResult), secretName, payload)
+}
+
+// AddSecretVersionWithResultWithContext mocks base method.
+func (m *MockISecret[T]) AddSecretVersionWithResultWithContext(ctx context.Context, secretName string, payload []byte) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecretVersionWithResultWithContext", ctx, secretName, payload)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSecretVersionWithResultWithContext indicates an expected call of AddSecretVersionWithResultWithContext.
+func (mr *MockISecretMockRecorder[T]) AddSecretVersionWithResultWithContext(ctx, secretName, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretVersionWithResultWithContext", reflect.TypeOf((*MockISecret[T])(nil).AddSecretVersionWithResultWithContext), ctx, secretName, payload)
+}
+
 // CreateSecret mocks base method.
 func (m *MockISecret[T]) CreateSecret(secretName string) error {
 	m.ctrl.T.Helper()
@@ -69,6 +128,20 @@ func (mr *MockISecretMockRecorder[T]) CreateSecret(secretName any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockISecret[T])(nil).CreateSecret), secretName)
 }
 
+// CreateSecretWithContext mocks base method.
+func (m *MockISecret[T]) CreateSecretWithContext(ctx context.Context, secretName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecretWithContext", ctx, secretName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSecretWithContext indicates an expected call of CreateSecretWithContext.
+func (mr *MockISecretMockRecorder[T]) CreateSecretWithContext(ctx, secretName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecretWithContext", reflect.TypeOf((*MockISecret[T])(nil).CreateSecretWithContext), ctx, secretName)
+}
+
 // Get mocks base method.
 func (m *MockISecret[T]) Get(name string) (T, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +172,21 @@ func (mr *MockISecretMockRecorder[T]) GetBytes(name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBytes", reflect.TypeOf((*MockISecret[T])(nil).GetBytes), name)
 }
 
+// GetBytesWithContext mocks base method.
+func (m *MockISecret[T]) GetBytesWithContext(ctx context.Context, name string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBytesWithContext", ctx, name)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBytesWithContext indicates an expected call of GetBytesWithContext.
+func (mr *MockISecretMockRecorder[T]) GetBytesWithContext(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBytesWithContext", reflect.TypeOf((*MockISecret[T])(nil).GetBytesWithContext), ctx, name)
+}
+
 // GetSecrets mocks base method.
 func (m *MockISecret[T]) GetSecrets(secretsRegexp *regexp.Regexp) ([]secret.SecretData, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +202,21 @@ func (mr *MockISecretMockRecorder[T]) GetSecrets(secretsRegexp any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecrets", reflect.TypeOf((*MockISecret[T])(nil).GetSecrets), secretsRegexp)
 }
 
+// GetSecretsWithContext mocks base method.
+func (m *MockISecret[T]) GetSecretsWithContext(ctx context.Context, secretsRegexp *regexp.Regexp) ([]secret.SecretData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretsWithContext", ctx, secretsRegexp)
+	ret0, _ := ret[0].([]secret.SecretData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretsWithContext indicates an expected call of GetSecretsWithContext.
+func (mr *MockISecretMockRecorder[T]) GetSecretsWithContext(ctx, secretsRegexp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretsWithContext", reflect.TypeOf((*MockISecret[T])(nil).GetSecretsWithContext), ctx, secretsRegexp)
+}
+
 // GetVersion mocks base method.
 func (m *MockISecret[T]) GetVersion(name, version string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -128,3 +231,89 @@ func (mr *MockISecretMockRecorder[T]) GetVersion(name, version any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockISecret[T])(nil).GetVersion), name, version)
 }
+
+// GetVersionWithContext mocks base method.
+func (m *MockISecret[T]) GetVersionWithContext(ctx context.Context, name, version string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionWithContext", ctx, name, version)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersionWithContext indicates an expected call of GetVersionWithContext.
+func (mr *MockISecretMockRecorder[T]) GetVersionWithContext(ctx, name, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionWithContext", reflect.TypeOf((*MockISecret[T])(nil).GetVersionWithContext), ctx, name, version)
+}
+
+// GetWithContext mocks base method.
+func (m *MockISecret[T]) GetWithContext(ctx context.Context, name string) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithContext", ctx, name)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithContext indicates an expected call of GetWithContext.
+func (mr *MockISecretMockRecorder[T]) GetWithContext(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithContext", reflect.TypeOf((*MockISecret[T])(nil).GetWithContext), ctx, name)
+}
+
+// RemoveIAMBinding mocks base method.
+func (m *MockISecret[T]) RemoveIAMBinding(secretName, member, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveIAMBinding", secretName, member, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveIAMBinding indicates an expected call of RemoveIAMBinding.
+func (mr *MockISecretMockRecorder[T]) RemoveIAMBinding(secretName, member, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIAMBinding", reflect.TypeOf((*MockISecret[T])(nil).RemoveIAMBinding), secretName, member, role)
+}
+
+// RemoveIAMBindingWithContext mocks base method.
+func (m *MockISecret[T]) RemoveIAMBindingWithContext(ctx context.Context, secretName, member, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveIAMBindingWithContext", ctx, secretName, member, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveIAMBindingWithContext indicates an expected call of RemoveIAMBindingWithContext.
+func (mr *MockISecretMockRecorder[T]) RemoveIAMBindingWithContext(ctx, secretName, member, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIAMBindingWithContext", reflect.TypeOf((*MockISecret[T])(nil).RemoveIAMBindingWithContext), ctx, secretName, member, role)
+}
+
+// UpsertSecretVersion mocks base method.
+func (m *MockISecret[T]) UpsertSecretVersion(secretName string, payload []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSecretVersion", secretName, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSecretVersion indicates an expected call of UpsertSecretVersion.
+func (mr *MockISecretMockRecorder[T]) UpsertSecretVersion(secretName, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSecretVersion", reflect.TypeOf((*MockISecret[T])(nil).UpsertSecretVersion), secretName, payload)
+}
+
+// UpsertSecretVersionWithContext mocks base method.
+func (m *MockISecret[T]) UpsertSecretVersionWithContext(ctx context.Context, secretName string, payload []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSecretVersionWithContext", ctx, secretName, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSecretVersionWithContext indicates an expected call of UpsertSecretVersionWithContext.
+func (mr *MockISecretMockRecorder[T]) UpsertSecretVersionWithContext(ctx, secretName, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSecretVersionWithContext", reflect.TypeOf((*MockISecret[T])(nil).UpsertSecretVersionWithContext), ctx, secretName, payload)
+}
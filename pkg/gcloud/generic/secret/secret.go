@@ -1,6 +1,7 @@
 package secret
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -9,6 +10,8 @@ import (
 	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
 
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // New creates a new Secret client
@@ -34,6 +37,30 @@ func New[T any](opts ...Option) (ISecret[T], error) {
 	}, nil
 }
 
+// secretNameRegexp is the format Secret Manager accepts for secret names.
+var secretNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// maxSecretNameLength is the maximum length of a secret name.
+const maxSecretNameLength = 255
+
+// validateSecretName checks that name is a valid Secret Manager secret name.
+func validateSecretName(name string) error {
+	if name == "" {
+		return ErrInvalidSecretName{Value: "invalid secret name"}
+	}
+	if len(name) > maxSecretNameLength {
+		return ErrInvalidSecretName{
+			Value: fmt.Sprintf("%q: must be at most %d characters", name, maxSecretNameLength),
+		}
+	}
+	if !secretNameRegexp.MatchString(name) {
+		return ErrInvalidSecretName{
+			Value: fmt.Sprintf("%q: must only contain letters, numbers, underscores and hyphens", name),
+		}
+	}
+	return nil
+}
+
 type SecretData struct {
 	Data []byte
 	Name string
@@ -47,10 +74,22 @@ type SecretData struct {
 //   - []byte: The secret
 //   - error: An error if one occurs.
 func (s *secret[T]) GetBytes(name string) ([]byte, error) {
-	if name == "" {
-		return nil, ErrInvalidSecretName{Value: "invalid secret name"}
+	return s.GetBytesWithContext(s.conf.Context, name)
+}
+
+// GetBytesWithContext gets a secret from Secret Manager using ctx for the call
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - name: string [The secret name]
+//
+// Returns:
+//   - []byte: The secret
+//   - error: An error if one occurs.
+func (s *secret[T]) GetBytesWithContext(ctx context.Context, name string) ([]byte, error) {
+	if err := validateSecretName(name); err != nil {
+		return nil, err
 	}
-	return s.GetVersion(name, "latest")
+	return s.GetVersionWithContext(ctx, name, "latest")
 }
 
 // Get gets a secret from Secret Manager
@@ -61,11 +100,23 @@ func (s *secret[T]) GetBytes(name string) ([]byte, error) {
 //   - T: The secret
 //   - error: An error if one occurs.
 func (s *secret[T]) Get(name string) (T, error) {
+	return s.GetWithContext(s.conf.Context, name)
+}
+
+// GetWithContext gets a secret from Secret Manager using ctx for the call
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - name: string [The secret name]
+//
+// Returns:
+//   - T: The secret
+//   - error: An error if one occurs.
+func (s *secret[T]) GetWithContext(ctx context.Context, name string) (T, error) {
 	var t T
-	if name == "" {
-		return t, ErrInvalidSecretName{Value: "invalid secret name"}
+	if err := validateSecretName(name); err != nil {
+		return t, err
 	}
-	sec, err := s.GetVersion(name, "latest")
+	sec, err := s.GetVersionWithContext(ctx, name, "latest")
 	if err != nil {
 		return t, err
 	}
@@ -85,8 +136,21 @@ func (s *secret[T]) Get(name string) (T, error) {
 //   - []byte: The secret
 //   - error: An error if one occurs.
 func (s *secret[T]) GetVersion(name string, version string) ([]byte, error) {
-	if name == "" {
-		return nil, ErrInvalidSecretName{Value: "invalid secret name"}
+	return s.GetVersionWithContext(s.conf.Context, name, version)
+}
+
+// GetVersionWithContext fetches secret based on name and version using ctx for the call
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - name: string [The secret name]
+//   - version: string [The secret version]
+//
+// Returns:
+//   - []byte: The secret
+//   - error: An error if one occurs.
+func (s *secret[T]) GetVersionWithContext(ctx context.Context, name string, version string) ([]byte, error) {
+	if err := validateSecretName(name); err != nil {
+		return nil, err
 	}
 	if version == "" {
 		return nil, ErrInvalidSecretVersion{Value: "invalid secret version"}
@@ -103,7 +167,7 @@ func (s *secret[T]) GetVersion(name string, version string) ([]byte, error) {
 	}
 
 	// Call the API.
-	result, err := s.client.AccessSecretVersion(s.conf.Context, req)
+	result, err := s.client.AccessSecretVersion(ctx, req)
 	if err != nil {
 		err = fmt.Errorf("failed to access secret version: %v", err)
 		return nil, err
@@ -119,6 +183,18 @@ func (s *secret[T]) GetVersion(name string, version string) ([]byte, error) {
 //   - []SecretData: The secret data
 //   - error: An error if one occurs.
 func (s *secret[T]) GetSecrets(secretsRegexp *regexp.Regexp) ([]SecretData, error) {
+	return s.GetSecretsWithContext(s.conf.Context, secretsRegexp)
+}
+
+// GetSecretsWithContext from projectId using secretsRegexp, using ctx for the calls
+// Parameters:
+//   - ctx: context.Context [The context of the calls]
+//   - secretsRegexp: *regexp.Regexp [The regular expression to match secrets]
+//
+// Returns:
+//   - []SecretData: The secret data
+//   - error: An error if one occurs.
+func (s *secret[T]) GetSecretsWithContext(ctx context.Context, secretsRegexp *regexp.Regexp) ([]SecretData, error) {
 	var secretsData []SecretData
 
 	if secretsRegexp == nil {
@@ -133,7 +209,7 @@ func (s *secret[T]) GetSecrets(secretsRegexp *regexp.Regexp) ([]SecretData, erro
 	req := &secretmanagerpb.ListSecretsRequest{
 		Parent: parent,
 	}
-	it := s.client.ListSecrets(s.conf.Context, req)
+	it := s.client.ListSecrets(ctx, req)
 	for {
 		resp, err := it.Next()
 		if err == iterator.Done {
@@ -150,7 +226,7 @@ func (s *secret[T]) GetSecrets(secretsRegexp *regexp.Regexp) ([]SecretData, erro
 			}
 
 			// Call the API.
-			result, err := s.client.AccessSecretVersion(s.conf.Context, req)
+			result, err := s.client.AccessSecretVersion(ctx, req)
 			if err != nil {
 				return secretsData, fmt.Errorf("failed to access latest secret version %s: %v", resp.Name, err)
 			}
@@ -171,23 +247,57 @@ func (s *secret[T]) GetSecrets(secretsRegexp *regexp.Regexp) ([]SecretData, erro
 //
 // Returns:
 //   - error: An error if one occurs.
+//
+// Deprecated: use AddSecretVersionWithResult, which also returns the created version.
 func (s *secret[T]) AddSecretVersion(secretName string, payload []byte) error {
-	if secretName == "" {
-		return ErrInvalidSecretName{Value: "invalid secret name"}
+	_, err := s.AddSecretVersionWithResultWithContext(s.conf.Context, secretName, payload)
+	return err
+}
+
+// AddSecretVersionWithResult adds a secret version to Secret Manager
+// Parameters:
+//   - secretName: string [The secret name]
+//   - payload: []byte [The secret payload]
+//
+// Returns:
+//   - string: The resource name of the created version,
+//     e.g. projects/my-project/secrets/my-secret/versions/3
+//   - error: An error if one occurs.
+func (s *secret[T]) AddSecretVersionWithResult(secretName string, payload []byte) (string, error) {
+	return s.AddSecretVersionWithResultWithContext(s.conf.Context, secretName, payload)
+}
+
+// AddSecretVersionWithResultWithContext adds a secret version to Secret Manager using ctx for the call
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - secretName: string [The secret name]
+//   - payload: []byte [The secret payload]
+//
+// Returns:
+//   - string: The resource name of the created version,
+//     e.g. projects/my-project/secrets/my-secret/versions/3
+//   - error: An error if one occurs.
+func (s *secret[T]) AddSecretVersionWithResultWithContext(
+	ctx context.Context,
+	secretName string,
+	payload []byte,
+) (string, error) {
+	if err := validateSecretName(secretName); err != nil {
+		return "", err
 	}
 
 	if payload == nil {
-		return ErrInvalidSecretData{Value: "nil data"}
+		return "", ErrInvalidSecretData{Value: "nil data"}
 	}
 
 	if len(payload) == 0 {
-		return ErrInvalidSecretData{Value: "empty data"}
+		return "", ErrInvalidSecretData{Value: "empty data"}
 	}
 
 	parent := "projects/" + s.conf.ProjectId + "/secrets/" + secretName
 
 	if s.client == nil {
-		return ErrFailedToCreateClient{Value: "secret manager client is not initialized"}
+		return "", ErrFailedToCreateClient{Value: "secret manager client is not initialized"}
 	}
 
 	// Build the request.
@@ -199,11 +309,11 @@ func (s *secret[T]) AddSecretVersion(secretName string, payload []byte) error {
 	}
 
 	// Call the API.
-	_, err := s.client.AddSecretVersion(s.conf.Context, req)
+	version, err := s.client.AddSecretVersion(ctx, req)
 	if err != nil {
-		return ErrFailedToCreateSecret{Value: fmt.Sprintf("failed to add secret version: %v", err)}
+		return "", ErrFailedToCreateSecret{Value: fmt.Sprintf("failed to add secret version: %v", err)}
 	}
-	return nil
+	return version.GetName(), nil
 }
 
 // CreateSecret creates a secret in Secret Manager
@@ -213,22 +323,92 @@ func (s *secret[T]) AddSecretVersion(secretName string, payload []byte) error {
 // Returns:
 //   - error: An error if one occurs.
 func (s *secret[T]) CreateSecret(secretName string) error {
-	if secretName == "" {
-		return ErrInvalidSecretName{Value: "invalid secret name"}
+	return s.CreateSecretWithContext(s.conf.Context, secretName)
+}
+
+// CreateSecretWithContext creates a secret in Secret Manager using ctx for the call
+// Parameters:
+//   - ctx: context.Context [The context of the call]
+//   - secretName: string [The secret name]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (s *secret[T]) CreateSecretWithContext(ctx context.Context, secretName string) error {
+	req, err := s.createSecretRequest(secretName)
+	if err != nil {
+		return err
+	}
+
+	// Call the API.
+	_, err = s.client.CreateSecret(ctx, req)
+	if err != nil {
+		return ErrFailedToCreateSecret{Value: fmt.Sprintf("failed to create secret: %v", err)}
+	}
+	return nil
+}
+
+// UpsertSecretVersion creates the secret if it does not exist and adds a secret version to it
+// Parameters:
+//   - secretName: string [The secret name]
+//   - payload: []byte [The secret payload]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (s *secret[T]) UpsertSecretVersion(secretName string, payload []byte) error {
+	return s.UpsertSecretVersionWithContext(s.conf.Context, secretName, payload)
+}
+
+// UpsertSecretVersionWithContext creates the secret if it does not exist and adds a secret
+// version to it, using ctx for the calls
+// Parameters:
+//   - ctx: context.Context [The context of the calls]
+//   - secretName: string [The secret name]
+//   - payload: []byte [The secret payload]
+//
+// Returns:
+//   - error: An error if one occurs.
+func (s *secret[T]) UpsertSecretVersionWithContext(ctx context.Context, secretName string, payload []byte) error {
+	if payload == nil {
+		return ErrInvalidSecretData{Value: "nil data"}
+	}
+
+	if len(payload) == 0 {
+		return ErrInvalidSecretData{Value: "empty data"}
+	}
+
+	req, err := s.createSecretRequest(secretName)
+	if err != nil {
+		return err
+	}
+
+	// Call the API, an existing secret only needs a new version.
+	_, err = s.client.CreateSecret(ctx, req)
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		return ErrFailedToCreateSecret{Value: fmt.Sprintf("failed to create secret: %v", err)}
+	}
+	_, err = s.AddSecretVersionWithResultWithContext(ctx, secretName, payload)
+	return err
+}
+
+// createSecretRequest validates the secret name and builds the request creating it
+// with automatic replication.
+func (s *secret[T]) createSecretRequest(secretName string) (*secretmanagerpb.CreateSecretRequest, error) {
+	if err := validateSecretName(secretName); err != nil {
+		return nil, err
 	}
 
 	if s.conf.ProjectId == "" {
-		return ErrProjectIdBlank{Value: "project ID is required"}
+		return nil, ErrProjectIdBlank{Value: "project ID is required"}
 	}
 
 	parent := "projects/" + s.conf.ProjectId
 
 	if s.client == nil {
-		return ErrFailedToCreateClient{Value: "secret manager client is not initialized"}
+		return nil, ErrFailedToCreateClient{Value: "secret manager client is not initialized"}
 	}
 
 	// Build the request.
-	req := &secretmanagerpb.CreateSecretRequest{
+	return &secretmanagerpb.CreateSecretRequest{
 		Parent:   parent,
 		SecretId: secretName,
 		Secret: &secretmanagerpb.Secret{
@@ -238,12 +418,5 @@ func (s *secret[T]) CreateSecret(secretName string) error {
 				},
 			},
 		},
-	}
-
-	// Call the API.
-	_, err := s.client.CreateSecret(s.conf.Context, req)
-	if err != nil {
-		return ErrFailedToCreateSecret{Value: fmt.Sprintf("failed to create secret: %v", err)}
-	}
-	return nil
+	}, nil
 }
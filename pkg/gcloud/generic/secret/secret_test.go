@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -93,6 +94,78 @@ func TestSecretInputValidation(t *testing.T) {
 			},
 			wantErr: secret.ErrInvalidSecretVersion{Value: "invalid secret version"},
 		},
+		{
+			name: "GetBytesWithContext empty name",
+			fn: func() error {
+				_, err := testClient(t).GetBytesWithContext(context.Background(), "")
+				return err
+			},
+			wantErr: secret.ErrInvalidSecretName{Value: "invalid secret name"},
+		},
+		{
+			name: "GetWithContext empty name",
+			fn: func() error {
+				_, err := testClient(t).GetWithContext(context.Background(), "")
+				return err
+			},
+			wantErr: secret.ErrInvalidSecretName{Value: "invalid secret name"},
+		},
+		{
+			name: "GetVersionWithContext empty version",
+			fn: func() error {
+				_, err := testClient(t).GetVersionWithContext(context.Background(), "test-secret", "")
+				return err
+			},
+			wantErr: secret.ErrInvalidSecretVersion{Value: "invalid secret version"},
+		},
+		{
+			name: "GetSecretsWithContext nil pattern",
+			fn: func() error {
+				_, err := testClient(t).GetSecretsWithContext(context.Background(), nil)
+				return err
+			},
+			wantErr: secret.ErrInvalidPattern{Value: "nil pattern"},
+		},
+		{
+			name: "CreateSecretWithContext empty name",
+			fn: func() error {
+				return testClient(t).CreateSecretWithContext(context.Background(), "")
+			},
+			wantErr: secret.ErrInvalidSecretName{Value: "invalid secret name"},
+		},
+		{
+			name: "UpsertSecretVersionWithContext empty payload",
+			fn: func() error {
+				return testClient(t).UpsertSecretVersionWithContext(context.Background(), "test-secret", []byte{})
+			},
+			wantErr: secret.ErrInvalidSecretData{Value: "empty data"},
+		},
+		{
+			name: "AddSecretVersionWithResultWithContext nil payload",
+			fn: func() error {
+				_, err := testClient(t).AddSecretVersionWithResultWithContext(context.Background(), "test-secret", nil)
+				return err
+			},
+			wantErr: secret.ErrInvalidSecretData{Value: "nil data"},
+		},
+		{
+			name: "AddIAMBindingWithContext invalid member",
+			fn: func() error {
+				return testClient(t).AddIAMBindingWithContext(
+					context.Background(), "test-secret", "app@my-project.iam.gserviceaccount.com", "roles/secretmanager.secretAccessor",
+				)
+			},
+			wantErr: secret.ErrInvalidMember{Value: "app@my-project.iam.gserviceaccount.com"},
+		},
+		{
+			name: "RemoveIAMBindingWithContext invalid role",
+			fn: func() error {
+				return testClient(t).RemoveIAMBindingWithContext(
+					context.Background(), "test-secret", "serviceAccount:app@my-project.iam.gserviceaccount.com", "secretAccessor",
+				)
+			},
+			wantErr: secret.ErrInvalidRole{Value: "secretAccessor"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -119,6 +192,20 @@ func TestSecretCreateSecret(t *testing.T) {
 			secretName: "",
 			wantErr:    secret.ErrInvalidSecretName{Value: "invalid secret name"},
 		},
+		{
+			name:       "invalid characters",
+			secretName: "my.secret",
+			wantErr: secret.ErrInvalidSecretName{
+				Value: `"my.secret": must only contain letters, numbers, underscores and hyphens`,
+			},
+		},
+		{
+			name:       "too long",
+			secretName: strings.Repeat("a", 256),
+			wantErr: secret.ErrInvalidSecretName{
+				Value: fmt.Sprintf("%q: must be at most 255 characters", strings.Repeat("a", 256)),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -172,6 +259,119 @@ func TestSecretAddSecretVersion(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 			}
+
+			version, err := client.AddSecretVersionWithResult(tt.secretName, tt.data)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+				assert.Empty(t, version)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, version)
+			}
+		})
+	}
+}
+
+func TestSecretUpsertSecretVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		secretName string
+		data       []byte
+		wantErr    error
+	}{
+		{
+			name:       "empty secret name",
+			secretName: "",
+			data:       []byte("test data"),
+			wantErr:    secret.ErrInvalidSecretName{Value: "invalid secret name"},
+		},
+		{
+			name:       "nil data",
+			secretName: "test-secret",
+			data:       nil,
+			wantErr:    secret.ErrInvalidSecretData{Value: "nil data"},
+		},
+		{
+			name:       "empty data",
+			secretName: "test-secret",
+			data:       []byte{},
+			wantErr:    secret.ErrInvalidSecretData{Value: "empty data"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testClient(t)
+			err := client.UpsertSecretVersion(tt.secretName, tt.data)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSecretIAMBindingValidation(t *testing.T) {
+	const (
+		member = "serviceAccount:app@test-project.iam.gserviceaccount.com"
+		role   = "roles/secretmanager.secretAccessor"
+	)
+	tests := []struct {
+		name       string
+		secretName string
+		member     string
+		role       string
+		wantErr    error
+	}{
+		{
+			name:       "empty secret name",
+			secretName: "",
+			member:     member,
+			role:       role,
+			wantErr:    secret.ErrInvalidSecretName{Value: "invalid secret name"},
+		},
+		{
+			name:       "member without principal type",
+			secretName: "test-secret",
+			member:     "app@test-project.iam.gserviceaccount.com",
+			role:       role,
+			wantErr:    secret.ErrInvalidMember{Value: "app@test-project.iam.gserviceaccount.com"},
+		},
+		{
+			name:       "member with empty principal",
+			secretName: "test-secret",
+			member:     "user:",
+			role:       role,
+			wantErr:    secret.ErrInvalidMember{Value: "user:"},
+		},
+		{
+			name:       "role without prefix",
+			secretName: "test-secret",
+			member:     member,
+			role:       "secretmanager.secretAccessor",
+			wantErr:    secret.ErrInvalidRole{Value: "secretmanager.secretAccessor"},
+		},
+		{
+			name:       "custom role without name",
+			secretName: "test-secret",
+			member:     member,
+			role:       "projects/test-project/roles/",
+			wantErr:    secret.ErrInvalidRole{Value: "projects/test-project/roles/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testClient(t)
+
+			err := client.AddIAMBinding(tt.secretName, tt.member, tt.role)
+			assert.Equal(t, tt.wantErr, err)
+
+			err = client.RemoveIAMBinding(tt.secretName, tt.member, tt.role)
+			assert.Equal(t, tt.wantErr, err)
 		})
 	}
 }
@@ -0,0 +1,47 @@
+package git
+
+import (
+	"fmt"
+	"io"
+)
+
+// Formats accepted by DownloadArchive.
+const (
+	ArchiveFormatTarball = "tarball"
+	ArchiveFormatZipball = "zipball"
+)
+
+// DownloadArchive downloads the repository at a ref as a gzipped tarball or a zipball, e.g. to
+// process the whole tree without fetching it file by file. GitHub redirects the request to
+// codeload.github.com, which is followed without the token.
+// Parameters:
+//   - ref: The branch, tag or commit SHA to download.
+//   - format: ArchiveFormatTarball or ArchiveFormatZipball.
+//   - w: The writer the archive is streamed into.
+//
+// Returns:
+//   - ErrInvalidRef if the ref is empty.
+//   - ErrInvalidArchiveFormat if the format is neither tarball nor zipball.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK, e.g. when the
+//     ref does not exist.
+//   - An error if the archive cannot be written to w, which may then hold a partial archive.
+func (g *git) DownloadArchive(ref string, format string, w io.Writer) error {
+	if ref == "" {
+		return ErrInvalidRef{Value: "ref is empty"}
+	}
+	if format != ArchiveFormatTarball && format != ArchiveFormatZipball {
+		return ErrInvalidArchiveFormat{Value: format}
+	}
+	resp, err := g.get("repos", fmt.Sprintf("%s/%s/%s/%s", g.cfg.Owner, g.cfg.Repo, format, ref), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return apiError(resp)
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download archive: %v", err)
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package git
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Config struct {
 	Owner   string // Owner is the Git repository owner
@@ -8,8 +11,18 @@ type Config struct {
 	Token   string // Token is the Git access token
 	BaseURL string // BaseURL is the base URL for the Git API
 
-	// Context is the context to use for BigQuery operations
+	// Context is the context of the API requests, unless a Context method variant is used
 	Context context.Context
+
+	Logger  Logger      // Logger receives a structured entry for every API call
+	Metrics MetricsFunc // Metrics is invoked after every API call
+
+	RecorderDir  string       // RecorderDir is the directory used to record and replay API calls
+	RecorderMode RecorderMode // RecorderMode selects whether API calls are recorded or replayed
+	APIVersion   string       // APIVersion pins the GitHub REST API version sent in X-GitHub-Api-Version
+
+	MaxRetries   int           // MaxRetries is how many times a request that failed with a 5xx is retried
+	RetryBackoff time.Duration // RetryBackoff is the wait before the first retry, doubled for every retry
 }
 type Option func(cfg *Config)
 
@@ -64,8 +77,74 @@ func WithBaseURL(url string) Option {
 	}
 }
 
+// WithLogger sets the logger used to log every API call. The token is never logged.
+func WithLogger(l Logger) Option {
+	if l == nil {
+		panic("logger is nil")
+	}
+	return func(cfg *Config) {
+		cfg.Logger = l
+	}
+}
+
+// WithMetrics sets a hook that is invoked after every API call with the HTTP method,
+// the response status code and the duration of the call.
+func WithMetrics(fn func(method string, status int, dur time.Duration)) Option {
+	if fn == nil {
+		panic("metrics hook is nil")
+	}
+	return func(cfg *Config) {
+		cfg.Metrics = fn
+	}
+}
+
+// WithRecorder records API calls as fixtures in dir or replays them from there. In RecordMode
+// every request is sent to the API and its response saved; in ReplayMode every request is
+// served from the fixture of the same request (method, url and body) and the API is never
+// called. Request headers are never stored, so fixtures do not contain the token.
+func WithRecorder(dir string, mode RecorderMode) Option {
+	if dir == "" {
+		panic("recorder dir is empty")
+	}
+	if mode != RecordMode && mode != ReplayMode {
+		panic("recorder mode must be RecordMode or ReplayMode")
+	}
+	return func(cfg *Config) {
+		cfg.RecorderDir = dir
+		cfg.RecorderMode = mode
+	}
+}
+
+// WithAPIVersion pins the GitHub REST API version, e.g. "2022-11-28", by sending it in the
+// X-GitHub-Api-Version header of every API call.
+func WithAPIVersion(v string) Option {
+	if v == "" {
+		panic("api version is empty")
+	}
+	return func(cfg *Config) {
+		cfg.APIVersion = v
+	}
+}
+
+// WithRetry retries requests that fail with a 5xx status, e.g. a 502 or 503 during a GitHub
+// incident, up to maxRetries times. The wait between attempts starts at backoff and doubles for
+// every retry. Only requests that are safe to repeat are retried, see CreateUpdateMultipleFiles.
+func WithRetry(maxRetries int, backoff time.Duration) Option {
+	if maxRetries < 0 {
+		panic("max retries is negative")
+	}
+	if backoff < 0 {
+		panic("retry backoff is negative")
+	}
+	return func(cfg *Config) {
+		cfg.MaxRetries = maxRetries
+		cfg.RetryBackoff = backoff
+	}
+}
+
 func defaultConfig() *Config {
 	return &Config{
 		Context: context.Background(),
+		Logger:  noopLogger{},
 	}
 }
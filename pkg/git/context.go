@@ -0,0 +1,93 @@
+package git
+
+import (
+	"context"
+	"net/http"
+)
+
+// withContext returns a copy of the client that sends its requests with ctx instead of the
+// context of the configuration. The copy shares the http client and the rest of the configuration.
+func (g *git) withContext(ctx context.Context) *git {
+	cfg := *g.cfg
+	cfg.Context = ctx
+	return &git{cfg: &cfg, client: g.client}
+}
+
+// GetBranchContext is GetBranch with ctx used for the request instead of the client context.
+func (g *git) GetBranchContext(ctx context.Context, branch string) (*BranchInfo, error) {
+	return g.withContext(ctx).GetBranch(branch)
+}
+
+// EnsureBranchContext is EnsureBranch with ctx used for the requests instead of the client context.
+func (g *git) EnsureBranchContext(ctx context.Context, branch string, baseBranch string) (*BranchInfo, error) {
+	return g.withContext(ctx).EnsureBranch(branch, baseBranch)
+}
+
+// GetAFileContext is GetAFile with ctx used for the request instead of the client context.
+func (g *git) GetAFileContext(ctx context.Context, branch string, filePath string) (*FileInfo, error) {
+	return g.withContext(ctx).GetAFile(branch, filePath)
+}
+
+// GetContentsContext is GetContents with ctx used for the requests instead of the client context.
+func (g *git) GetContentsContext(ctx context.Context, branch string, path string) (*Contents, error) {
+	return g.withContext(ctx).GetContents(branch, path)
+}
+
+// CreateUpdateAFileContext is CreateUpdateAFile with ctx used for the request instead of the
+// client context.
+func (g *git) CreateUpdateAFileContext(
+	ctx context.Context,
+	branch string,
+	filePath string,
+	content []byte,
+	message string,
+	sha string,
+) (*FileResponse, error) {
+	return g.withContext(ctx).CreateUpdateAFile(branch, filePath, content, message, sha)
+}
+
+// CreateUpdateMultipleFilesContext is CreateUpdateMultipleFiles with ctx used for the requests
+// instead of the client context. Cancelling ctx before the branch reference is updated leaves the
+// branch unchanged; the blobs, tree and commit created until then are never referenced.
+func (g *git) CreateUpdateMultipleFilesContext(ctx context.Context, batch BatchFileUpdate) error {
+	return g.withContext(ctx).CreateUpdateMultipleFiles(batch)
+}
+
+// CreatePullRequestContext is CreatePullRequest with ctx used for the requests instead of the
+// client context.
+func (g *git) CreatePullRequestContext(
+	ctx context.Context,
+	baseBranch string,
+	branch string,
+	title string,
+	description string,
+) (int, error) {
+	return g.withContext(ctx).CreatePullRequest(baseBranch, branch, title, description)
+}
+
+// ListWorkflowRunsContext is ListWorkflowRuns with ctx used for the requests instead of the
+// client context.
+func (g *git) ListWorkflowRunsContext(
+	ctx context.Context,
+	workflowFileOrID string,
+	opts WorkflowRunOptions,
+) ([]WorkflowRun, error) {
+	return g.withContext(ctx).ListWorkflowRuns(workflowFileOrID, opts)
+}
+
+// SearchIssuesContext is SearchIssues with ctx used for the request instead of the client context.
+func (g *git) SearchIssuesContext(ctx context.Context, query string, opts SearchOptions) (*SearchResult, error) {
+	return g.withContext(ctx).SearchIssues(query, opts)
+}
+
+// ProposeChangesContext is ProposeChanges with ctx used for the requests instead of the client
+// context. When ctx is done partway, the branch cannot be deleted either and the returned
+// ErrProposeFailed has BranchDeleted false.
+func (g *git) ProposeChangesContext(ctx context.Context, req ProposeRequest) (int, error) {
+	return g.withContext(ctx).ProposeChanges(req)
+}
+
+// DoContext is Do with ctx used for the request instead of the client context.
+func (g *git) DoContext(ctx context.Context, method string, path string, body interface{}) (*http.Response, error) {
+	return g.withContext(ctx).Do(method, path, body)
+}
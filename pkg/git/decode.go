@@ -0,0 +1,60 @@
+package git
+
+import (
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// GetFileAs retrieves a file from the repository at a given branch and decodes it into T.
+// The file is decoded as JSON or YAML depending on its extension (.json, .yaml or .yml).
+// Parameters:
+//   - g: The git client used to fetch the file.
+//   - branch: The name of the branch where the file is located.
+//   - filePath: The path to the file within the repository.
+//
+// Returns:
+//   - The decoded file content.
+//   - An error if the file can't be fetched or decoded, or ErrUnsupportedFileFormat
+//     if the file extension is not supported.
+func GetFileAs[T any](g IGit, branch string, filePath string) (T, error) {
+	var t T
+	var unmarshal func([]byte, any) error
+	switch strings.ToLower(path.Ext(filePath)) {
+	case ".json":
+		unmarshal = json.Unmarshal
+	case ".yaml", ".yml":
+		unmarshal = yaml.Unmarshal
+	default:
+		return t, ErrUnsupportedFileFormat{Value: filePath}
+	}
+
+	fileInfo, err := g.GetAFile(branch, filePath)
+	if err != nil {
+		return t, err
+	}
+	if fileInfo == nil {
+		return t, ErrFileNotFound{Value: filePath}
+	}
+	content, err := decodeContent(fileInfo)
+	if err != nil {
+		return t, fmt.Errorf("failed to decode file %s: %w", filePath, err)
+	}
+	if err := unmarshal(content, &t); err != nil {
+		return t, fmt.Errorf("failed to unmarshal file %s: %w", filePath, err)
+	}
+	return t, nil
+}
+
+// decodeContent returns the raw content of a file returned by the contents API.
+// GitHub wraps base64 content at 60 characters, so newlines are removed before decoding.
+func decodeContent(fileInfo *FileInfo) ([]byte, error) {
+	if fileInfo.Encoding != "" && fileInfo.Encoding != "base64" {
+		return []byte(fileInfo.Content), nil
+	}
+	return b64.StdEncoding.DecodeString(strings.ReplaceAll(fileInfo.Content, "\n", ""))
+}
@@ -2,6 +2,8 @@ package git
 
 import (
 	"fmt"
+	"strings"
+	"time"
 )
 
 type ErrBranchNotFound struct {
@@ -20,6 +22,14 @@ func (e ErrFailedToCreateBranch) Error() string {
 	return fmt.Sprintf("failed to create branch: %s", e.Value)
 }
 
+type ErrInvalidSHA struct {
+	Value string
+}
+
+func (e ErrInvalidSHA) Error() string {
+	return fmt.Sprintf("invalid sha: %s", e.Value)
+}
+
 type ErrFailedToGetBranch struct {
 	Value string
 }
@@ -35,3 +45,173 @@ type ErrFailedToDeleteBranch struct {
 func (e ErrFailedToDeleteBranch) Error() string {
 	return fmt.Sprintf("failed to delete branch: %s", e.Value)
 }
+
+type ErrFileNotFound struct {
+	Value string
+}
+
+func (e ErrFileNotFound) Error() string {
+	return fmt.Sprintf("file not found: %s", e.Value)
+}
+
+type ErrUnsupportedFileFormat struct {
+	Value string
+}
+
+func (e ErrUnsupportedFileFormat) Error() string {
+	return fmt.Sprintf("unsupported file format: %s", e.Value)
+}
+
+type ErrInvalidFileMode struct {
+	Value string
+}
+
+func (e ErrInvalidFileMode) Error() string {
+	return fmt.Sprintf("invalid file mode, must be one of 100644, 100755 or 120000: %s", e.Value)
+}
+
+type ErrInvalidEncoding struct {
+	Value string
+}
+
+func (e ErrInvalidEncoding) Error() string {
+	return fmt.Sprintf("invalid blob encoding, must be utf-8 or base64: %s", e.Value)
+}
+
+type ErrInvalidArchiveFormat struct {
+	Value string
+}
+
+func (e ErrInvalidArchiveFormat) Error() string {
+	return fmt.Sprintf("invalid archive format, must be tarball or zipball: %s", e.Value)
+}
+
+type ErrInvalidBase struct {
+	Value string
+}
+
+func (e ErrInvalidBase) Error() string {
+	return fmt.Sprintf("invalid pull request base: %s", e.Value)
+}
+
+type ErrInvalidReviewEvent struct {
+	Value string
+}
+
+func (e ErrInvalidReviewEvent) Error() string {
+	return fmt.Sprintf("invalid review event, must be one of APPROVE, REQUEST_CHANGES or COMMENT: %s", e.Value)
+}
+
+type ErrInvalidAssignees struct {
+	Value string
+}
+
+func (e ErrInvalidAssignees) Error() string {
+	return fmt.Sprintf("invalid assignees: %s", e.Value)
+}
+
+type ErrInvalidEventType struct {
+	Value string
+}
+
+func (e ErrInvalidEventType) Error() string {
+	return fmt.Sprintf("invalid event type: %s", e.Value)
+}
+
+type ErrInvalidRef struct {
+	Value string
+}
+
+func (e ErrInvalidRef) Error() string {
+	return fmt.Sprintf("invalid ref: %s", e.Value)
+}
+
+type ErrInvalidWorkflow struct {
+	Value string
+}
+
+func (e ErrInvalidWorkflow) Error() string {
+	return fmt.Sprintf("invalid workflow: %s", e.Value)
+}
+
+type ErrInvalidStatusState struct {
+	Value string
+}
+
+func (e ErrInvalidStatusState) Error() string {
+	return fmt.Sprintf("invalid status state, must be one of error, failure, pending or success: %s", e.Value)
+}
+
+type ErrInvalidPattern struct {
+	Value string
+}
+
+func (e ErrInvalidPattern) Error() string {
+	return fmt.Sprintf("invalid glob pattern: %s", e.Value)
+}
+
+type ErrInvalidFiles struct {
+	Value string
+}
+
+func (e ErrInvalidFiles) Error() string {
+	return fmt.Sprintf("invalid files: %s", e.Value)
+}
+
+type ErrFixtureNotFound struct {
+	Value string
+}
+
+func (e ErrFixtureNotFound) Error() string {
+	return fmt.Sprintf("no recorded fixture for request: %s", e.Value)
+}
+
+type ErrTreeTruncated struct {
+	Value string
+}
+
+func (e ErrTreeTruncated) Error() string {
+	return fmt.Sprintf("tree is too large to be listed recursively: %s", e.Value)
+}
+
+// ErrProposeFailed is returned by ProposeChanges when one of its steps fails. BranchDeleted reports
+// whether the branch created for the changes was deleted again.
+type ErrProposeFailed struct {
+	Step          string
+	Err           error
+	BranchDeleted bool
+}
+
+func (e ErrProposeFailed) Error() string {
+	return fmt.Sprintf("failed to propose changes, %s failed: %v", e.Step, e.Err)
+}
+
+func (e ErrProposeFailed) Unwrap() error {
+	return e.Err
+}
+
+// ErrRateLimited is returned when a request is rejected because the rate limit of the token is
+// exhausted. Reset is when requests are accepted again.
+type ErrRateLimited struct {
+	Reset time.Time
+}
+
+func (e ErrRateLimited) Error() string {
+	return fmt.Sprintf("github rate limit exceeded, resets at %s", e.Reset.Format(time.RFC3339))
+}
+
+// ErrGitHubAPI is returned when GitHub rejects a request, with the error body GitHub sent back.
+type ErrGitHubAPI struct {
+	StatusCode       int
+	Message          string
+	Errors           []string
+	DocumentationURL string
+}
+
+func (e ErrGitHubAPI) Error() string {
+	msg := fmt.Sprintf("github api error (%d): %s", e.StatusCode, e.Message)
+	if len(e.Errors) > 0 {
+		msg = fmt.Sprintf("%s: %s", msg, strings.Join(e.Errors, ", "))
+	}
+	return msg
+}
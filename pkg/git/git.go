@@ -2,15 +2,24 @@ package git
 
 //go:generate mockgen -source=interface.go -destination=mocks/mock-git.go -package=mocks
 import (
+	"bytes"
 	b64 "encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
 )
 
+// shaPattern matches a full SHA-1 or SHA-256 object name.
+var shaPattern = regexp.MustCompile(`^([0-9a-f]{40}|[0-9a-f]{64})$`)
+
 type git struct {
-	cfg *Config
+	cfg    *Config
+	client *http.Client
 }
 
 func New(opts ...Option) *git {
@@ -18,9 +27,51 @@ func New(opts ...Option) *git {
 	for _, opt := range opts {
 		opt(n.cfg)
 	}
+	client := &http.Client{}
+	if n.cfg.RecorderDir != "" {
+		client.Transport = newRecorder(n.cfg.RecorderDir, n.cfg.RecorderMode, http.DefaultTransport)
+	}
 	return &git{
-		cfg: n.cfg,
+		cfg:    n.cfg,
+		client: client,
+	}
+}
+
+// GetRepository retrieves the metadata of the repository, e.g. to check that it is not archived,
+// where writes are rejected, before operating on it.
+// Returns:
+//   - A pointer to a Repository struct containing the default branch, visibility, archived and
+//     fork flags, description and the permissions of the token.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK.
+func (g *git) GetRepository() (*Repository, error) {
+	resp, err := g.get("repos", fmt.Sprintf("%s/%s", g.cfg.Owner, g.cfg.Repo), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, apiError(resp)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var repo Repository
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return nil, err
 	}
+	return &repo, nil
+}
+
+// GetDefaultBranch retrieves the name of the default branch of the repository, e.g. main or master.
+// Returns:
+//   - The name of the default branch.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK.
+func (g *git) GetDefaultBranch() (string, error) {
+	repo, err := g.GetRepository()
+	if err != nil {
+		return "", err
+	}
+	return repo.DefaultBranch, nil
 }
 
 // GetBranch retrieves information about a specific branch in the repository.
@@ -64,8 +115,48 @@ func (g *git) GetBranch(branch string) (*BranchInfo, error) {
 //
 // Returns:
 //   - A pointer to a BranchInfo struct containing information about the created branch, or nil if successful.
+//   - ErrInvalidSHA if no commit has the SHA.
 //   - An error if the request fails or if the response status is not 201 Created.
 func (g *git) CreateBranch(branch string, sha string) (*BranchInfo, error) {
+	resp, err := g.createRef(branch, sha)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == 422 {
+		err := apiError(resp)
+		if apiErr, ok := err.(ErrGitHubAPI); ok && apiErr.Message == "Object does not exist" {
+			return nil, ErrInvalidSHA{Value: sha}
+		}
+		return nil, fmt.Errorf("failed to create a branch %s: %w", branch, err)
+	}
+	if resp.StatusCode != 201 {
+		return nil, fmt.Errorf("failed to create a branch %s: %s", branch, resp.Status)
+	}
+	return nil, nil
+}
+
+// CreateBranchFromBranch creates a new branch pointing to the head of an existing branch.
+// Parameters:
+//   - newBranch: The name of the new branch to create.
+//   - fromBranch: The name of the branch whose head the new branch will point to.
+//
+// Returns:
+//   - A pointer to a BranchInfo struct containing information about the created branch, or nil if successful.
+//   - ErrBranchNotFound if fromBranch does not exist.
+//   - An error if a request fails or if the response status is not 201 Created.
+func (g *git) CreateBranchFromBranch(newBranch string, fromBranch string) (*BranchInfo, error) {
+	fromInfo, err := g.GetBranch(fromBranch)
+	if err != nil {
+		return nil, err
+	}
+	if fromInfo == nil {
+		return nil, ErrBranchNotFound{Value: fromBranch}
+	}
+	return g.CreateBranch(newBranch, fromInfo.Object.Sha)
+}
+
+// createRef sends the request creating branch at sha.
+func (g *git) createRef(branch string, sha string) (*http.Response, error) {
 	reqBody := map[string]string{
 		"ref": fmt.Sprintf("refs/heads/%s", branch),
 		"sha": sha,
@@ -74,14 +165,66 @@ func (g *git) CreateBranch(branch string, sha string) (*BranchInfo, error) {
 	if err != nil {
 		return nil, err
 	}
-	resp, err := g.post("repos", fmt.Sprintf("%s/%s/git/refs", g.cfg.Owner, g.cfg.Repo), nil, reqBodyJson)
+	return g.post("repos", fmt.Sprintf("%s/%s/git/refs", g.cfg.Owner, g.cfg.Repo), nil, reqBodyJson)
+}
+
+// EnsureBranch returns the branch if it exists, otherwise creates it from the head of baseBranch.
+// Parameters:
+//   - branch: The name of the branch to ensure.
+//   - baseBranch: The name of the branch the new branch is created from, or empty for the
+//     default branch of the repository.
+//
+// Returns:
+//   - A pointer to a BranchInfo struct containing the existing or created branch.
+//   - ErrBranchNotFound if baseBranch does not exist, or an error if a request fails.
+//     A branch created concurrently between the check and the create is returned as existing.
+func (g *git) EnsureBranch(branch string, baseBranch string) (*BranchInfo, error) {
+	branchInfo, err := g.GetBranch(branch)
+	if err != nil {
+		return nil, err
+	}
+	if branchInfo != nil {
+		return branchInfo, nil
+	}
+	if baseBranch == "" {
+		baseBranch, err = g.GetDefaultBranch()
+		if err != nil {
+			return nil, err
+		}
+	}
+	baseInfo, err := g.GetBranch(baseBranch)
 	if err != nil {
 		return nil, err
 	}
-	if resp.StatusCode != 201 {
-		return nil, fmt.Errorf("failed to create a branch %s: %s", branch, resp.Status)
+	if baseInfo == nil {
+		return nil, ErrBranchNotFound{Value: baseBranch}
 	}
-	return nil, nil
+	resp, err := g.createRef(branch, baseInfo.Object.Sha)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case 201:
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		var created BranchInfo
+		if err := json.Unmarshal(body, &created); err != nil {
+			return nil, err
+		}
+		return &created, nil
+	case 422:
+		// The branch was created between the check and the create.
+		branchInfo, err := g.GetBranch(branch)
+		if err != nil {
+			return nil, err
+		}
+		if branchInfo != nil {
+			return branchInfo, nil
+		}
+	}
+	return nil, ErrFailedToCreateBranch{Value: fmt.Sprintf("%s: %s", branch, resp.Status)}
 }
 
 // GetAFile retrieves information about a specific file in the repository at a given branch.
@@ -90,7 +233,8 @@ func (g *git) CreateBranch(branch string, sha string) (*BranchInfo, error) {
 //   - filePath: The path to the file within the repository.
 //
 // Returns:
-//   - A pointer to a FileInfo struct containing details about the file, or nil if the file does not exist.
+//   - A pointer to a FileInfo struct containing details about the file.
+//   - ErrFileNotFound if the file does not exist.
 //   - An error if the request fails or if the response status is not 200 OK.
 func (g *git) GetAFile(branch string, filePath string) (*FileInfo, error) {
 	var fileInfo FileInfo
@@ -101,16 +245,11 @@ func (g *git) GetAFile(branch string, filePath string) (*FileInfo, error) {
 		return nil, err
 	}
 	if resp.StatusCode == 404 {
-		return nil, fmt.Errorf("file not found: %s", filePath)
+		return nil, ErrFileNotFound{Value: filePath}
 	}
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("failed to get file %s: %s", filePath, resp.Status)
 	}
-	if resp.StatusCode == 404 {
-		return nil, nil
-	} else if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get file %s: %s", filePath, resp.Status)
-	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
@@ -119,9 +258,136 @@ func (g *git) GetAFile(branch string, filePath string) (*FileInfo, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := g.loadLargeFile(&fileInfo); err != nil {
+		return nil, err
+	}
 	return &fileInfo, nil
 }
 
+// loadLargeFile fills in the content of a file over 1MB, which the contents API returns without
+// content and with the "none" encoding, from its blob.
+func (g *git) loadLargeFile(fileInfo *FileInfo) error {
+	if fileInfo.Type != ContentTypeFile || fileInfo.Encoding != "none" {
+		return nil
+	}
+	content, err := g.GetBlob(fileInfo.Sha)
+	if err != nil {
+		return fmt.Errorf("failed to get large file %s: %w", fileInfo.Path, err)
+	}
+	fileInfo.Content = b64.StdEncoding.EncodeToString(content)
+	fileInfo.Encoding = "base64"
+	return nil
+}
+
+// GetContents retrieves a file or a directory of the repository at a given branch, for callers that
+// don't know in advance which of the two a path is.
+// Parameters:
+//   - branch: The name of the branch where the path is located.
+//   - path: The path within the repository, empty for the root directory.
+//
+// Returns:
+//   - A pointer to a Contents struct with the decoded content of a file or the entries of a directory.
+//   - ErrFileNotFound if the path does not exist.
+//   - An error if the request fails or if the response status is not 200 OK.
+func (g *git) GetContents(branch string, path string) (*Contents, error) {
+	qs := url.Values{}
+	qs.Add("ref", branch)
+	resp, err := g.get("repos", fmt.Sprintf("%s/%s/contents/%s", g.cfg.Owner, g.cfg.Repo, path), qs)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == 404 {
+		return nil, ErrFileNotFound{Value: path}
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get contents %s: %s", path, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	// The contents API returns an array for a directory and an object for anything else.
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		var entries []FileInfo
+		if err := json.Unmarshal(body, &entries); err != nil {
+			return nil, err
+		}
+		return &Contents{Type: ContentTypeDir, Path: path, Entries: entries}, nil
+	}
+	var fileInfo FileInfo
+	if err := json.Unmarshal(body, &fileInfo); err != nil {
+		return nil, err
+	}
+	if err := g.loadLargeFile(&fileInfo); err != nil {
+		return nil, err
+	}
+	contents := &Contents{Type: fileInfo.Type, Path: path, File: &fileInfo}
+	if fileInfo.Type == ContentTypeFile {
+		contents.Content, err = decodeContent(&fileInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode file %s: %w", path, err)
+		}
+	}
+	return contents, nil
+}
+
+// ListTreeFiltered lists the entries of a tree recursively and keeps those whose path matches
+// one of the include patterns, or any path when include is empty, and none of the exclude patterns.
+// Patterns are matched against the whole path with path.Match semantics per segment, and a "**"
+// segment matches any number of directories, e.g. "**/*.go" or "vendor/**".
+// Parameters:
+//   - sha: The SHA of the tree or commit, or a branch name.
+//   - include: The patterns of the paths to keep, all paths when empty.
+//   - exclude: The patterns of the paths to drop.
+//
+// Returns:
+//   - The matching blob and tree entries.
+//   - ErrInvalidPattern if a pattern is malformed.
+//   - ErrTreeTruncated if the tree has too many entries to be listed in one call.
+//   - An error if the request fails or if the response status is not 200 OK.
+func (g *git) ListTreeFiltered(sha string, include []string, exclude []string) ([]TreeEntry, error) {
+	if err := validateGlobs(include); err != nil {
+		return nil, err
+	}
+	if err := validateGlobs(exclude); err != nil {
+		return nil, err
+	}
+	qs := url.Values{}
+	qs.Set("recursive", "1")
+	resp, err := g.get("repos", fmt.Sprintf("%s/%s/git/trees/%s", g.cfg.Owner, g.cfg.Repo, sha), qs)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get tree %s: %s", sha, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var treeResp TreeResponse
+	if err := json.Unmarshal(body, &treeResp); err != nil {
+		return nil, err
+	}
+	if treeResp.Truncated {
+		return nil, ErrTreeTruncated{Value: sha}
+	}
+	entries := []TreeEntry{}
+	for _, entry := range treeResp.Tree {
+		if len(include) > 0 {
+			// patterns are validated above, so matching can't fail
+			if ok, _ := matchAny(include, entry.Path); !ok {
+				continue
+			}
+		}
+		if ok, _ := matchAny(exclude, entry.Path); ok {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
 // CreateUpdateAFile creates or updates a file in the repository at a specified branch.
 // Parameters:
 //   - branch: The name of the branch where the file will be created or updated.
@@ -179,7 +445,8 @@ func (g *git) CreateUpdateAFile(
 
 // CreatePullRequest creates a pull request and returns the pull request number.
 // Parameters:
-//   - baseBranch: The name of the branch where the pull request will be merged into.
+//   - baseBranch: The name of the branch where the pull request will be merged into, or empty
+//     for the default branch of the repository.
 //   - branch: The name of the branch that contains the changes to be merged.
 //   - title: The title of the pull request.
 //   - description: The description of the pull request.
@@ -193,6 +460,13 @@ func (g *git) CreatePullRequest(
 	title string,
 	description string,
 ) (int, error) {
+	if baseBranch == "" {
+		defaultBranch, err := g.GetDefaultBranch()
+		if err != nil {
+			return 0, err
+		}
+		baseBranch = defaultBranch
+	}
 	reqBody := map[string]any{
 		"title":                 title,
 		"body":                  description,
@@ -223,6 +497,52 @@ func (g *git) CreatePullRequest(
 	return pullResponse.Number, nil
 }
 
+// UpdatePullRequestBase changes the base branch of a pull request, e.g. to retarget a stacked pull
+// request once the one below it is merged.
+// Parameters:
+//   - number: The pull request number to retarget.
+//   - newBase: The name of the branch the pull request will be merged into.
+//
+// Returns:
+//   - ErrInvalidRef if newBase is empty.
+//   - ErrInvalidBase with the reasons given by GitHub if it rejects the new base, e.g. when the
+//     branch does not exist or is already the base of the pull request.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK otherwise.
+//   - nil if the base is successfully updated.
+func (g *git) UpdatePullRequestBase(number int, newBase string) error {
+	if newBase == "" {
+		return ErrInvalidRef{Value: "base branch is empty"}
+	}
+	reqBodyJson, err := json.Marshal(map[string]string{"base": newBase})
+	if err != nil {
+		return err
+	}
+	resp, err := g.patch(
+		"repos",
+		fmt.Sprintf("%s/%s/pulls/%d", g.cfg.Owner, g.cfg.Repo, number),
+		nil,
+		reqBodyJson,
+	)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == 422 {
+		err := apiError(resp)
+		if apiErr, ok := err.(ErrGitHubAPI); ok {
+			reasons := apiErr.Errors
+			if len(reasons) == 0 {
+				reasons = []string{apiErr.Message}
+			}
+			return ErrInvalidBase{Value: fmt.Sprintf("%s: %s", newBase, strings.Join(reasons, "; "))}
+		}
+		return err
+	}
+	if resp.StatusCode != 200 {
+		return apiError(resp)
+	}
+	return nil
+}
+
 // AddReviewers adds reviewers to a pull request.
 // Parameters:
 //   - number: The pull request number to which reviewers will be added.
@@ -261,157 +581,745 @@ func (g *git) AddReviewers(number int, prReviewers Reviewers) error {
 	return nil
 }
 
-type FileOperation struct {
-	Path    string `json:"path"`
-	Content string `json:"content"`
-	Sha     string `json:"sha,omitempty"`
-}
-
-type BatchFileUpdate struct {
-	Branch  string          `json:"branch"`
-	Message string          `json:"message"`
-	Files   []FileOperation `json:"files"`
+// AddAssignees assigns users to a pull request or issue, in addition to its current assignees.
+// Parameters:
+//   - number: The pull request or issue number.
+//   - assignees: The logins of the users to assign.
+//
+// Returns:
+//   - ErrInvalidAssignees if assignees is empty.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 201 Created.
+//   - nil if the assignees are successfully added.
+func (g *git) AddAssignees(number int, assignees []string) error {
+	return g.assignees(http.MethodPost, number, assignees, 201)
 }
 
-// CreateUpdateMultipleFiles updates or creates multiple files in a repository branch using the Git Database API.
-// This method creates blobs for each file, creates a new tree with the changes, creates a commit,
-// and updates the branch reference to point to the new commit.
-//
+// RemoveAssignees unassigns users from a pull request or issue.
 // Parameters:
-//   - batch: A BatchFileUpdate struct containing the branch name, commit message,
-//     and a list of files to be created or updated. Each file is represented by a
-//     FileOperation struct, which includes the file path and content. The Sha field
-//     is ignored for this method as it uses the Git Database API workflow.
+//   - number: The pull request or issue number.
+//   - assignees: The logins of the users to unassign.
 //
 // Returns:
-// - An error if the operation fails, or nil if the files are successfully updated.
-func (g *git) CreateUpdateMultipleFiles(batch BatchFileUpdate) error {
-	// Step 1: Get the current branch reference to get the current commit SHA
-	branchInfo, err := g.GetBranch(batch.Branch)
+//   - ErrInvalidAssignees if assignees is empty.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK.
+//   - nil if the assignees are successfully removed.
+func (g *git) RemoveAssignees(number int, assignees []string) error {
+	return g.assignees(http.MethodDelete, number, assignees, 200)
+}
+
+// assignees sends the assignees of an issue with method to the assignees endpoint, which
+// pull requests share with issues.
+func (g *git) assignees(method string, number int, assignees []string, wantStatus int) error {
+	if len(assignees) == 0 {
+		return ErrInvalidAssignees{Value: "no assignees given"}
+	}
+	for _, assignee := range assignees {
+		if assignee == "" {
+			return ErrInvalidAssignees{Value: "assignee login is empty"}
+		}
+	}
+	reqBodyJson, err := json.Marshal(map[string][]string{"assignees": assignees})
 	if err != nil {
-		return fmt.Errorf("failed to get branch %s: %w", batch.Branch, err)
+		return err
 	}
-
-	currentCommitSha := branchInfo.Object.Sha
-
-	// Step 2: Get the current tree from the current commit
-	resp, err := g.get("repos", fmt.Sprintf("%s/%s/git/commits/%s", g.cfg.Owner, g.cfg.Repo, currentCommitSha), nil)
+	resp, err := g.do(
+		method,
+		"repos",
+		fmt.Sprintf("%s/%s/issues/%d/assignees", g.cfg.Owner, g.cfg.Repo, number),
+		nil,
+		reqBodyJson,
+	)
 	if err != nil {
-		return fmt.Errorf("failed to get current commit: %w", err)
+		return err
 	}
-	if resp.StatusCode != 200 {
-		return fmt.Errorf("failed to get current commit: %s", resp.Status)
+	if resp.StatusCode != wantStatus {
+		return apiError(resp)
 	}
+	return nil
+}
 
-	body, err := io.ReadAll(resp.Body)
+// perPage is the page size used by the list methods, the maximum allowed by GitHub.
+const perPage = 100
+
+// Review events accepted by SubmitReview.
+const (
+	ReviewEventApprove        = "APPROVE"
+	ReviewEventRequestChanges = "REQUEST_CHANGES"
+	ReviewEventComment        = "COMMENT"
+)
+
+// SubmitReview submits a review on a pull request.
+// Parameters:
+//   - number: The pull request number to review.
+//   - event: The review action, one of APPROVE, REQUEST_CHANGES or COMMENT.
+//   - body: The review comment, required by GitHub for REQUEST_CHANGES and COMMENT.
+//
+// Returns:
+//   - ErrInvalidReviewEvent if the event is not supported.
+//   - ErrGitHubAPI with the GitHub error body if GitHub rejects the review, e.g. when approving your own pull request.
+//   - nil if the review is successfully submitted.
+func (g *git) SubmitReview(number int, event string, body string) error {
+	switch event {
+	case ReviewEventApprove, ReviewEventRequestChanges, ReviewEventComment:
+	default:
+		return ErrInvalidReviewEvent{Value: event}
+	}
+	reqBody := map[string]string{
+		"event": event,
+	}
+	if body != "" {
+		reqBody["body"] = body
+	}
+	reqBodyJson, err := json.Marshal(reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to read commit response: %w", err)
+		return err
 	}
-
-	var commitInfo CommitResponse
-	if err := json.Unmarshal(body, &commitInfo); err != nil {
-		return fmt.Errorf("failed to parse commit response: %w", err)
+	resp, err := g.post(
+		"repos",
+		fmt.Sprintf("%s/%s/pulls/%d/reviews", g.cfg.Owner, g.cfg.Repo, number),
+		nil,
+		reqBodyJson,
+	)
+	if err != nil {
+		return err
 	}
+	if resp.StatusCode != 200 {
+		return apiError(resp)
+	}
+	return nil
+}
 
-	currentTreeSha := commitInfo.Tree.Sha
-
-	// Step 3: Create blobs for each file's content
-	var treeEntries []TreeEntry
-	for _, file := range batch.Files {
-		// Create blob for file content
-		blobReq := map[string]string{
-			"content":  file.Content,
-			"encoding": "utf-8",
-		}
-		blobReqJson, err := json.Marshal(blobReq)
-		if err != nil {
-			return fmt.Errorf("failed to marshal blob request for %s: %w", file.Path, err)
-		}
-
-		resp, err := g.post("repos", fmt.Sprintf("%s/%s/git/blobs", g.cfg.Owner, g.cfg.Repo), nil, blobReqJson)
+// ListReviews lists the reviews submitted on a pull request, following the pagination of the API.
+// Parameters:
+//   - number: The pull request number to list the reviews of.
+//
+// Returns:
+//   - The reviews in chronological order.
+//   - An error if any request fails or if a response status is not 200 OK.
+func (g *git) ListReviews(number int) ([]Review, error) {
+	var reviews []Review
+	for page := 1; ; page++ {
+		qs := url.Values{}
+		qs.Set("per_page", fmt.Sprint(perPage))
+		qs.Set("page", fmt.Sprint(page))
+		resp, err := g.get(
+			"repos",
+			fmt.Sprintf("%s/%s/pulls/%d/reviews", g.cfg.Owner, g.cfg.Repo, number),
+			qs,
+		)
 		if err != nil {
-			return fmt.Errorf("failed to create blob for %s: %w", file.Path, err)
+			return nil, err
 		}
-		if resp.StatusCode != 201 {
-			return fmt.Errorf("failed to create blob for %s: %s", file.Path, resp.Status)
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("failed to list reviews: %s", resp.Status)
 		}
-
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
-			return fmt.Errorf("failed to read blob response for %s: %w", file.Path, err)
+			return nil, err
 		}
-
-		var blobResp BlobResponse
-		if err := json.Unmarshal(body, &blobResp); err != nil {
-			return fmt.Errorf("failed to parse blob response for %s: %w", file.Path, err)
+		var pageReviews []Review
+		if err := json.Unmarshal(body, &pageReviews); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, pageReviews...)
+		if !hasNextPage(resp) {
+			return reviews, nil
 		}
+	}
+}
 
-		// Add tree entry for this file
-		treeEntries = append(treeEntries, TreeEntry{
-			Path: file.Path,
-			Mode: "100644", // Regular file mode
-			Type: "blob",
-			Sha:  blobResp.Sha,
-		})
+// CreateRepositoryDispatch triggers a repository_dispatch event, e.g. to start downstream workflows.
+// Parameters:
+//   - eventType: The event type workflows filter on with `on.repository_dispatch.types`.
+//   - payload: Optional data passed to the workflows as `github.event.client_payload`.
+//
+// Returns:
+//   - ErrInvalidEventType if the event type is empty.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 204 No Content.
+//   - nil if the event is successfully created.
+func (g *git) CreateRepositoryDispatch(eventType string, payload map[string]interface{}) error {
+	if eventType == "" {
+		return ErrInvalidEventType{Value: "event type is empty"}
+	}
+	reqBody := map[string]any{
+		"event_type": eventType,
 	}
+	if payload != nil {
+		reqBody["client_payload"] = payload
+	}
+	reqBodyJson, err := json.Marshal(reqBody)
+	if err != nil {
+		return err
+	}
+	resp, err := g.post("repos", fmt.Sprintf("%s/%s/dispatches", g.cfg.Owner, g.cfg.Repo), nil, reqBodyJson)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 204 {
+		return apiError(resp)
+	}
+	return nil
+}
 
-	// Step 4: Create a new tree with the file changes
-	treeReq := map[string]interface{}{
-		"base_tree": currentTreeSha,
-		"tree":      treeEntries,
+// TriggerWorkflow triggers a workflow_dispatch event for a GitHub Actions workflow.
+// Parameters:
+//   - workflowFileOrID: The workflow file name, e.g. deploy.yml, or the workflow ID.
+//   - ref: The branch or tag to run the workflow on.
+//   - inputs: Optional input keys and values defined by the workflow.
+//
+// Returns:
+//   - ErrInvalidWorkflow if the workflow is empty, ErrInvalidRef if the ref is empty.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 204 No Content.
+//   - nil if the workflow run is successfully requested.
+func (g *git) TriggerWorkflow(workflowFileOrID string, ref string, inputs map[string]string) error {
+	if workflowFileOrID == "" {
+		return ErrInvalidWorkflow{Value: "workflow is empty"}
 	}
-	treeReqJson, err := json.Marshal(treeReq)
+	if ref == "" {
+		return ErrInvalidRef{Value: "ref is empty"}
+	}
+	reqBody := map[string]any{
+		"ref": ref,
+	}
+	if len(inputs) > 0 {
+		reqBody["inputs"] = inputs
+	}
+	reqBodyJson, err := json.Marshal(reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to marshal tree request: %w", err)
+		return err
 	}
+	resp, err := g.post(
+		"repos",
+		fmt.Sprintf(
+			"%s/%s/actions/workflows/%s/dispatches",
+			g.cfg.Owner, g.cfg.Repo, url.PathEscape(workflowFileOrID),
+		),
+		nil,
+		reqBodyJson,
+	)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 204 {
+		return apiError(resp)
+	}
+	return nil
+}
+
+// ListWorkflowRuns lists the runs of a GitHub Actions workflow, most recent first, following the
+// pagination of the API until opts.MaxRuns runs are listed.
+// Parameters:
+//   - workflowFileOrID: The workflow file name, e.g. deploy.yml, or the workflow ID.
+//   - opts: Optional branch, status and event filters and the maximum number of runs.
+//
+// Returns:
+//   - At most opts.MaxRuns workflow runs matching the filters, 100 when it is zero.
+//   - ErrInvalidWorkflow if the workflow is empty.
+//   - An error if any request fails or if a response status is not 200 OK.
+func (g *git) ListWorkflowRuns(workflowFileOrID string, opts WorkflowRunOptions) ([]WorkflowRun, error) {
+	if workflowFileOrID == "" {
+		return nil, ErrInvalidWorkflow{Value: "workflow is empty"}
+	}
+	limit := opts.MaxRuns
+	if limit <= 0 {
+		limit = perPage
+	}
+	var runs []WorkflowRun
+	for page := 1; ; page++ {
+		qs := opts.values()
+		qs.Set("per_page", fmt.Sprint(min(limit, perPage)))
+		qs.Set("page", fmt.Sprint(page))
+		resp, err := g.get(
+			"repos",
+			fmt.Sprintf(
+				"%s/%s/actions/workflows/%s/runs",
+				g.cfg.Owner, g.cfg.Repo, url.PathEscape(workflowFileOrID),
+			),
+			qs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("failed to list workflow runs: %s", resp.Status)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		var runsResp workflowRunsResponse
+		if err := json.Unmarshal(body, &runsResp); err != nil {
+			return nil, err
+		}
+		runs = append(runs, runsResp.WorkflowRuns...)
+		if len(runs) >= limit {
+			return runs[:limit], nil
+		}
+		if !hasNextPage(resp) {
+			return runs, nil
+		}
+	}
+}
+
+// Commit status states accepted by CreateStatus.
+const (
+	StatusStateError   = "error"
+	StatusStateFailure = "failure"
+	StatusStatePending = "pending"
+	StatusStateSuccess = "success"
+)
 
-	resp, err = g.post("repos", fmt.Sprintf("%s/%s/git/trees", g.cfg.Owner, g.cfg.Repo), nil, treeReqJson)
+// CreateStatus reports a commit status, e.g. the result of an automation run, on a commit.
+// Parameters:
+//   - sha: The SHA of the commit to set the status on.
+//   - state: The state of the status, one of error, failure, pending or success.
+//   - targetURL: Optional URL linked from the status, e.g. the build logs.
+//   - description: Optional short description of the status.
+//   - context: A label differentiating this status from the statuses of other systems, defaults to "default".
+//
+// Returns:
+//   - ErrInvalidStatusState if the state is not supported.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 201 Created.
+//   - nil if the status is successfully created.
+func (g *git) CreateStatus(sha string, state string, targetURL string, description string, context string) error {
+	switch state {
+	case StatusStateError, StatusStateFailure, StatusStatePending, StatusStateSuccess:
+	default:
+		return ErrInvalidStatusState{Value: state}
+	}
+	reqBody := map[string]string{
+		"state": state,
+	}
+	if targetURL != "" {
+		reqBody["target_url"] = targetURL
+	}
+	if description != "" {
+		reqBody["description"] = description
+	}
+	if context != "" {
+		reqBody["context"] = context
+	}
+	reqBodyJson, err := json.Marshal(reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to create tree: %w", err)
+		return err
+	}
+	resp, err := g.post("repos", fmt.Sprintf("%s/%s/statuses/%s", g.cfg.Owner, g.cfg.Repo, sha), nil, reqBodyJson)
+	if err != nil {
+		return err
 	}
 	if resp.StatusCode != 201 {
-		return fmt.Errorf("failed to create tree: %s", resp.Status)
+		return apiError(resp)
 	}
+	return nil
+}
 
-	body, err = io.ReadAll(resp.Body)
+type FileOperation struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+	Sha     string `json:"sha,omitempty"`
+	Mode    string `json:"mode,omitempty"`   // Mode is the git file mode, defaults to 100644
+	Binary  bool   `json:"binary,omitempty"` // Binary sends Content base64 encoded so it is stored unchanged
+}
+
+// Git file modes accepted for a FileOperation.
+const (
+	FileModeRegular    = "100644"
+	FileModeExecutable = "100755"
+	FileModeSymlink    = "120000"
+)
+
+// blobContent returns the content and encoding of the blob of the file. Binary content, or
+// content that is not valid UTF-8, is base64 encoded so it is committed byte for byte.
+func (f FileOperation) blobContent() ([]byte, string) {
+	if f.Binary || !utf8.ValidString(f.Content) {
+		return []byte(f.Content), BlobEncodingBase64
+	}
+	return []byte(f.Content), BlobEncodingUTF8
+}
+
+// fileMode returns the tree entry mode of the file, defaulting to a regular file.
+func (f FileOperation) fileMode() (string, error) {
+	switch f.Mode {
+	case "":
+		return FileModeRegular, nil
+	case FileModeRegular, FileModeExecutable, FileModeSymlink:
+		return f.Mode, nil
+	default:
+		return "", ErrInvalidFileMode{Value: fmt.Sprintf("%s: %s", f.Path, f.Mode)}
+	}
+}
+
+// Encodings accepted by CreateBlob.
+const (
+	BlobEncodingUTF8   = "utf-8"
+	BlobEncodingBase64 = "base64"
+)
+
+// CreateBlob creates a blob in the repository, which can then be referenced by the tree
+// entries of one or more commits.
+// Parameters:
+//   - content: The raw content of the blob.
+//   - encoding: BlobEncodingUTF8 to send content as text, which must then be valid UTF-8, or
+//     BlobEncodingBase64 to send it base64 encoded, e.g. for binary files.
+//
+// Returns:
+//   - A pointer to a BlobResponse struct containing the sha of the blob.
+//   - ErrInvalidEncoding if the encoding is unknown or the content is not valid UTF-8.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 201 Created.
+func (g *git) CreateBlob(content []byte, encoding string) (*BlobResponse, error) {
+	blobReq := map[string]string{"encoding": encoding}
+	switch encoding {
+	case BlobEncodingUTF8:
+		if !utf8.Valid(content) {
+			return nil, ErrInvalidEncoding{Value: "content is not valid utf-8"}
+		}
+		blobReq["content"] = string(content)
+	case BlobEncodingBase64:
+		blobReq["content"] = b64.StdEncoding.EncodeToString(content)
+	default:
+		return nil, ErrInvalidEncoding{Value: encoding}
+	}
+	blobReqJson, err := json.Marshal(blobReq)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.post("repos", fmt.Sprintf("%s/%s/git/blobs", g.cfg.Owner, g.cfg.Repo), nil, blobReqJson)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 201 {
+		return nil, apiError(resp)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var blobResp BlobResponse
+	if err := json.Unmarshal(body, &blobResp); err != nil {
+		return nil, err
+	}
+	return &blobResp, nil
+}
+
+// GetBlob retrieves the content of a blob, e.g. of a file over the 1MB limit of GetAFile. Blobs
+// of up to 100MB are supported.
+// Parameters:
+//   - sha: The full SHA of the blob.
+//
+// Returns:
+//   - The raw content of the blob.
+//   - ErrInvalidSHA if the sha is not a full SHA-1 or SHA-256 hex string.
+//   - ErrInvalidEncoding if GitHub returns the content in an unknown encoding.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK.
+func (g *git) GetBlob(sha string) ([]byte, error) {
+	if !shaPattern.MatchString(sha) {
+		return nil, ErrInvalidSHA{Value: sha}
+	}
+	resp, err := g.get("repos", fmt.Sprintf("%s/%s/git/blobs/%s", g.cfg.Owner, g.cfg.Repo, sha), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, apiError(resp)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var blob struct {
+		Content  string `json:"content"`
+		Encoding string `json:"encoding"`
+		Size     int    `json:"size"`
+	}
+	if err := json.Unmarshal(body, &blob); err != nil {
+		return nil, err
+	}
+	var content []byte
+	switch blob.Encoding {
+	case BlobEncodingBase64:
+		// GitHub wraps base64 content at 60 characters.
+		content, err = b64.StdEncoding.DecodeString(strings.ReplaceAll(blob.Content, "\n", ""))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode blob %s: %w", sha, err)
+		}
+	case BlobEncodingUTF8:
+		content = []byte(blob.Content)
+	default:
+		return nil, ErrInvalidEncoding{Value: blob.Encoding}
+	}
+	if len(content) != blob.Size {
+		return nil, fmt.Errorf("blob %s is truncated: got %d of %d bytes", sha, len(content), blob.Size)
+	}
+	return content, nil
+}
+
+// CreateTree creates a tree with the Git Database API.
+// Parameters:
+//   - baseTreeSha: The sha of the tree the entries are applied on, or empty to create a tree
+//     with only the given entries.
+//   - entries: The tree entries, each with the path, mode, type and sha of a blob or tree.
+//
+// Returns:
+//   - A pointer to a TreeResponse struct containing the sha of the tree.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 201 Created.
+func (g *git) CreateTree(baseTreeSha string, entries []TreeEntry) (*TreeResponse, error) {
+	treeReq := map[string]interface{}{
+		"tree": entries,
+	}
+	if baseTreeSha != "" {
+		treeReq["base_tree"] = baseTreeSha
+	}
+	treeReqJson, err := json.Marshal(treeReq)
 	if err != nil {
-		return fmt.Errorf("failed to read tree response: %w", err)
+		return nil, err
 	}
 
+	resp, err := g.post("repos", fmt.Sprintf("%s/%s/git/trees", g.cfg.Owner, g.cfg.Repo), nil, treeReqJson)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 201 {
+		return nil, apiError(resp)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
 	var treeResp TreeResponse
 	if err := json.Unmarshal(body, &treeResp); err != nil {
-		return fmt.Errorf("failed to parse tree response: %w", err)
+		return nil, err
 	}
+	return &treeResp, nil
+}
 
-	// Step 5: Create a commit pointing to the new tree
+// CreateCommit creates a commit with the Git Database API. The commit is not referenced by any
+// branch until a reference is updated to point to it.
+// Parameters:
+//   - message: The commit message.
+//   - treeSha: The sha of the tree of the commit.
+//   - parents: The shas of the parent commits, usually the current head of the branch, or none
+//     for a root commit.
+//
+// Returns:
+//   - A pointer to a CommitResponse struct containing the sha of the commit.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 201 Created.
+func (g *git) CreateCommit(message string, treeSha string, parents []string) (*CommitResponse, error) {
+	if parents == nil {
+		parents = []string{}
+	}
 	commitReq := map[string]interface{}{
-		"message": batch.Message,
-		"tree":    treeResp.Sha,
-		"parents": []string{currentCommitSha},
+		"message": message,
+		"tree":    treeSha,
+		"parents": parents,
 	}
 	commitReqJson, err := json.Marshal(commitReq)
 	if err != nil {
-		return fmt.Errorf("failed to marshal commit request: %w", err)
+		return nil, err
 	}
 
-	resp, err = g.post("repos", fmt.Sprintf("%s/%s/git/commits", g.cfg.Owner, g.cfg.Repo), nil, commitReqJson)
+	resp, err := g.post("repos", fmt.Sprintf("%s/%s/git/commits", g.cfg.Owner, g.cfg.Repo), nil, commitReqJson)
 	if err != nil {
-		return fmt.Errorf("failed to create commit: %w", err)
+		return nil, err
 	}
 	if resp.StatusCode != 201 {
-		return fmt.Errorf("failed to create commit: %s", resp.Status)
+		return nil, apiError(resp)
 	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var commitResp CommitResponse
+	if err := json.Unmarshal(body, &commitResp); err != nil {
+		return nil, err
+	}
+	return &commitResp, nil
+}
 
-	body, err = io.ReadAll(resp.Body)
+// GetCommitVerification retrieves the signature verification of a commit, e.g. to reject unsigned
+// commits in compliance checks.
+// Parameters:
+//   - sha: The sha of the commit.
+//
+// Returns:
+//   - A pointer to a Verification struct containing whether the signature is verified, the reason,
+//     the signature and the signed payload.
+//   - ErrInvalidRef if the sha is empty.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK.
+func (g *git) GetCommitVerification(sha string) (*Verification, error) {
+	if sha == "" {
+		return nil, ErrInvalidRef{Value: "sha is empty"}
+	}
+	resp, err := g.get("repos", fmt.Sprintf("%s/%s/git/commits/%s", g.cfg.Owner, g.cfg.Repo, sha), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, apiError(resp)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var commit CommitResponse
+	if err := json.Unmarshal(body, &commit); err != nil {
+		return nil, err
+	}
+	return &commit.Verification, nil
+}
+
+// ListCommits lists the commits of a branch, most recent first, following the pagination of the
+// API until opts.MaxCommits commits are listed, e.g. to build the changelog of a file.
+// Parameters:
+//   - opts: Optional path, branch, date range and author filters and the maximum number of commits.
+//
+// Returns:
+//   - At most opts.MaxCommits commits matching the filters, 100 when it is zero, with their author,
+//     committer, message and parents.
+//   - ErrGitHubAPI with the GitHub error body if a response status is not 200 OK.
+func (g *git) ListCommits(opts CommitListOptions) ([]CommitResponse, error) {
+	limit := opts.MaxCommits
+	if limit <= 0 {
+		limit = perPage
+	}
+	var commits []CommitResponse
+	for page := 1; ; page++ {
+		qs := opts.values()
+		qs.Set("per_page", fmt.Sprint(min(limit, perPage)))
+		qs.Set("page", fmt.Sprint(page))
+		resp, err := g.get("repos", fmt.Sprintf("%s/%s/commits", g.cfg.Owner, g.cfg.Repo), qs)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != 200 {
+			return nil, apiError(resp)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		var pageCommits []listedCommit
+		if err := json.Unmarshal(body, &pageCommits); err != nil {
+			return nil, err
+		}
+		for _, listed := range pageCommits {
+			commit := listed.Commit
+			commit.Sha = listed.Sha
+			commit.NodeID = listed.NodeID
+			commit.Parents = listed.Parents
+			commits = append(commits, commit)
+		}
+		if len(commits) >= limit {
+			return commits[:limit], nil
+		}
+		if !hasNextPage(resp) {
+			return commits, nil
+		}
+	}
+}
+
+type BatchFileUpdate struct {
+	Branch  string          `json:"branch"`
+	Message string          `json:"message"`
+	Files   []FileOperation `json:"files"`
+}
+
+// CreateUpdateMultipleFiles updates or creates multiple files in a repository branch using the Git Database API.
+// This method creates blobs for each file, creates a new tree with the changes, creates a commit,
+// and updates the branch reference to point to the new commit.
+//
+// Parameters:
+//   - batch: A BatchFileUpdate struct containing the branch name, commit message,
+//     and a list of files to be created or updated. Each file is represented by a
+//     FileOperation struct, which includes the file path, content and optional mode
+//     (100644, 100755 or 120000). Binary content is base64 encoded when Binary is set
+//     or when the content is not valid UTF-8. The Sha field is ignored for this method as it uses
+//     the Git Database API workflow.
+//
+// The operation is safe to retry as a whole: the branch head is read again on every call, and when
+// the branch already contains the files no commit is created. With WithRetry, creating the blobs,
+// the tree and the commit is retried on 5xx. The final reference update is not, as it may have
+// been applied; its error is returned and calling the method again completes or skips it.
+//
+// Returns:
+// - An error if the operation fails, or nil if the files are successfully updated.
+func (g *git) CreateUpdateMultipleFiles(batch BatchFileUpdate) error {
+	modes := make([]string, len(batch.Files))
+	for i, file := range batch.Files {
+		mode, err := file.fileMode()
+		if err != nil {
+			return err
+		}
+		modes[i] = mode
+	}
+
+	// Step 1: Get the current branch reference to get the current commit SHA
+	branchInfo, err := g.GetBranch(batch.Branch)
+	if err != nil {
+		return fmt.Errorf("failed to get branch %s: %w", batch.Branch, err)
+	}
+	if branchInfo == nil {
+		return ErrBranchNotFound{Value: batch.Branch}
+	}
+
+	currentCommitSha := branchInfo.Object.Sha
+
+	// Step 2: Get the current tree from the current commit
+	resp, err := g.get("repos", fmt.Sprintf("%s/%s/git/commits/%s", g.cfg.Owner, g.cfg.Repo, currentCommitSha), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get current commit: %w", err)
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("failed to get current commit: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return fmt.Errorf("failed to read commit response: %w", err)
 	}
 
-	var newCommitResp CommitResponse
-	if err := json.Unmarshal(body, &newCommitResp); err != nil {
+	var commitInfo CommitResponse
+	if err := json.Unmarshal(body, &commitInfo); err != nil {
 		return fmt.Errorf("failed to parse commit response: %w", err)
 	}
 
+	currentTreeSha := commitInfo.Tree.Sha
+
+	// Step 3: Create blobs for each file's content
+	var treeEntries []TreeEntry
+	for i, file := range batch.Files {
+		blobResp, err := g.CreateBlob(file.blobContent())
+		if err != nil {
+			return fmt.Errorf("failed to create blob for %s: %w", file.Path, err)
+		}
+
+		// Add tree entry for this file
+		treeEntries = append(treeEntries, TreeEntry{
+			Path: file.Path,
+			Mode: modes[i],
+			Type: "blob",
+			Sha:  blobResp.Sha,
+		})
+	}
+
+	// Step 4: Create a new tree with the file changes
+	treeResp, err := g.CreateTree(currentTreeSha, treeEntries)
+	if err != nil {
+		return fmt.Errorf("failed to create tree: %w", err)
+	}
+
+	// The branch already has this content, e.g. when a previous call failed after updating
+	// the reference, so there is nothing to commit.
+	if treeResp.Sha == currentTreeSha {
+		return nil
+	}
+
+	// Step 5: Create a commit pointing to the new tree
+	newCommitResp, err := g.CreateCommit(batch.Message, treeResp.Sha, []string{currentCommitSha})
+	if err != nil {
+		return fmt.Errorf("failed to create commit: %w", err)
+	}
+
 	// Step 6: Update the branch reference to point to the new commit
 	refReq := map[string]interface{}{
 		"sha":   newCommitResp.Sha,
@@ -432,3 +1340,90 @@ func (g *git) CreateUpdateMultipleFiles(batch BatchFileUpdate) error {
 
 	return nil
 }
+
+// SearchIssues searches the issues and pull requests matching query with the Search API, e.g.
+// "repo:owner/repo is:pr is:open author:app/my-bot". The Search API has its own rate limit, much
+// lower than the one of the other endpoints, and returns at most 1000 results per query.
+// Parameters:
+//   - query: The search query, using the GitHub search syntax.
+//   - opts: The sort order and page of the results.
+//
+// Returns:
+//   - A pointer to a SearchResult struct containing the total count and the page of results.
+//   - ErrRateLimited if the search rate limit is exhausted.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK.
+func (g *git) SearchIssues(query string, opts SearchOptions) (*SearchResult, error) {
+	resp, err := g.get("search", "issues", opts.values(query))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		if rateErr, ok := rateLimited(resp); ok {
+			return nil, rateErr
+		}
+		return nil, apiError(resp)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var result SearchResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	if result.Items == nil {
+		result.Items = []SearchIssue{}
+	}
+	return &result, nil
+}
+
+// Do is the escape hatch for GitHub endpoints the client does not wrap. It sends a request to
+// path, relative to the API base URL and optionally with a query string, e.g.
+// "repos/{owner}/{repo}/labels?per_page=100", with the auth, accept, API version and retry
+// settings of the client. A non nil body is sent as JSON.
+// Parameters:
+//   - method: The HTTP method, e.g. http.MethodGet.
+//   - path: The endpoint path, e.g. "repos/owner/repo/issues".
+//   - body: The request body, marshaled to JSON, or nil for no body.
+//
+// Returns:
+//   - The raw response, whatever its status. The caller must close its body.
+//   - An error if the body cannot be marshaled or the request cannot be sent.
+func (g *git) Do(method string, path string, body interface{}) (*http.Response, error) {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %v", err)
+		}
+	}
+	return g.do(method, strings.TrimPrefix(path, "/"), "", nil, reqBody)
+}
+
+// GetRateLimit retrieves the current API quota of the authenticated token.
+// Returns:
+//   - A pointer to a RateLimit struct containing the core, search and graphql quotas.
+//   - An error if the request fails or if the response status is not 200 OK.
+func (g *git) GetRateLimit() (*RateLimit, error) {
+	resp, err := g.get("rate_limit", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("failed to get rate limit: %s", resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var rateLimitResp rateLimitResponse
+	if err := json.Unmarshal(body, &rateLimitResp); err != nil {
+		return nil, err
+	}
+	return &RateLimit{
+		Core:    rateLimitResp.Resources.Core.rate(),
+		Search:  rateLimitResp.Resources.Search.rate(),
+		GraphQL: rateLimitResp.Resources.GraphQL.rate(),
+	}, nil
+}
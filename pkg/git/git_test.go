@@ -1,11 +1,18 @@
 package git_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/pal-paul/go-libraries/pkg/git"
 	"github.com/stretchr/testify/assert"
@@ -182,6 +189,192 @@ func TestGitCreateBranch(t *testing.T) {
 	}
 }
 
+func TestGitBranchProtection(t *testing.T) {
+	t.Run("update", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/repos/test-owner/test-repo/branches/release/1.0/protection", r.URL.Path)
+			assert.Equal(t, "/repos/test-owner/test-repo/branches/release%2F1.0/protection", r.URL.EscapedPath())
+			assert.Equal(t, http.MethodPut, r.Method)
+
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{
+				"required_status_checks": {"strict": true, "contexts": ["ci/build"]},
+				"enforce_admins": true,
+				"required_pull_request_reviews": {
+					"dismiss_stale_reviews": true,
+					"require_code_owner_reviews": false,
+					"required_approving_review_count": 2
+				},
+				"restrictions": {"users": ["octocat"], "teams": [], "apps": []}
+			}`, string(body))
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		err := client.UpdateBranchProtection("release/1.0", git.BranchProtection{
+			RequiredStatusChecks: &git.RequiredStatusChecks{Strict: true, Contexts: []string{"ci/build"}},
+			RequiredReviews:      &git.RequiredReviews{RequiredApprovingReviewCount: 2, DismissStaleReviews: true},
+			EnforceAdmins:        true,
+			Restrictions:         &git.BranchRestrictions{Users: []string{"octocat"}},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("update disables nil rules", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{
+				"required_status_checks": {"strict": false, "contexts": []},
+				"enforce_admins": false,
+				"required_pull_request_reviews": null,
+				"restrictions": null
+			}`, string(body))
+
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "Validation Failed", "errors": ["Only organization repositories can have users and team restrictions"]}`))
+		}))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		err := client.UpdateBranchProtection("main", git.BranchProtection{
+			RequiredStatusChecks: &git.RequiredStatusChecks{},
+		})
+		assert.IsType(t, git.ErrGitHubAPI{}, err)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		server := setupMockServer(t, "/repos/test-owner/test-repo/branches/main/protection", http.MethodGet, http.StatusOK, []byte(`{
+			"url": "https://api.github.com/repos/test-owner/test-repo/branches/main/protection",
+			"required_status_checks": {"strict": true, "contexts": ["ci/build", "ci/lint"]},
+			"enforce_admins": {"url": "https://api.github.com/repos/test-owner/test-repo/branches/main/protection/enforce_admins", "enabled": true},
+			"required_pull_request_reviews": {"dismiss_stale_reviews": false, "require_code_owner_reviews": true, "required_approving_review_count": 1},
+			"restrictions": {
+				"users": [{"login": "octocat", "id": 1}],
+				"teams": [{"slug": "release-managers", "id": 2}],
+				"apps": [{"slug": "deploy-bot", "id": 3}]
+			}
+		}`))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		protection, err := client.GetBranchProtection("main")
+		assert.NoError(t, err)
+		assert.Equal(t, &git.BranchProtection{
+			RequiredStatusChecks: &git.RequiredStatusChecks{Strict: true, Contexts: []string{"ci/build", "ci/lint"}},
+			RequiredReviews:      &git.RequiredReviews{RequiredApprovingReviewCount: 1, RequireCodeOwnerReviews: true},
+			EnforceAdmins:        true,
+			Restrictions: &git.BranchRestrictions{
+				Users: []string{"octocat"},
+				Teams: []string{"release-managers"},
+				Apps:  []string{"deploy-bot"},
+			},
+		}, protection)
+	})
+
+	t.Run("get not protected", func(t *testing.T) {
+		server := setupMockServer(t, "/repos/test-owner/test-repo/branches/main/protection", http.MethodGet, http.StatusNotFound, []byte(`{"message": "Branch not protected"}`))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		protection, err := client.GetBranchProtection("main")
+		assert.NoError(t, err)
+		assert.Nil(t, protection)
+	})
+
+	t.Run("get missing branch", func(t *testing.T) {
+		server := setupMockServer(t, "/repos/test-owner/test-repo/branches/missing/protection", http.MethodGet, http.StatusNotFound, []byte(`{"message": "Branch not found"}`))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		protection, err := client.GetBranchProtection("missing")
+		assert.IsType(t, git.ErrGitHubAPI{}, err)
+		assert.Nil(t, protection)
+	})
+
+	t.Run("empty branch", func(t *testing.T) {
+		client := git.New(git.WithOwner("test-owner"), git.WithRepo("test-repo"))
+
+		_, err := client.GetBranchProtection("")
+		assert.IsType(t, git.ErrInvalidRef{}, err)
+		assert.IsType(t, git.ErrInvalidRef{}, client.UpdateBranchProtection("", git.BranchProtection{}))
+	})
+}
+
+func TestGitCreateBranchFromBranch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/test-owner/test-repo/git/refs/heads/main":
+			w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "main-sha", "type": "commit"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/test-owner/test-repo/git/refs/heads/gone":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/test-owner/test-repo/git/refs":
+			var reqBody map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+			if reqBody["sha"] != "main-sha" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message": "Object does not exist", "documentation_url": "https://docs.github.com/rest/git/refs#create-a-reference"}`))
+				return
+			}
+			assert.Equal(t, "refs/heads/release", reqBody["ref"])
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+	)
+
+	_, err := client.CreateBranchFromBranch("release", "main")
+	assert.NoError(t, err)
+
+	_, err = client.CreateBranchFromBranch("release", "gone")
+	assert.Equal(t, git.ErrBranchNotFound{Value: "gone"}, err)
+
+	_, err = client.CreateBranch("release", "unknown-sha")
+	assert.Equal(t, git.ErrInvalidSHA{Value: "unknown-sha"}, err)
+}
+
 func TestGitGetAFile(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -190,6 +383,7 @@ func TestGitGetAFile(t *testing.T) {
 		response  []byte
 		status    int
 		wantError bool
+		wantErr   error
 	}{
 		{
 			name:     "success",
@@ -215,6 +409,7 @@ func TestGitGetAFile(t *testing.T) {
 			filePath:  "nonexistent.txt",
 			status:    http.StatusNotFound,
 			wantError: true,
+			wantErr:   git.ErrFileNotFound{Value: "nonexistent.txt"},
 		},
 		{
 			name:      "server error",
@@ -248,6 +443,9 @@ func TestGitGetAFile(t *testing.T) {
 			if tt.wantError {
 				assert.Error(t, err)
 				assert.Nil(t, fileContent)
+				if tt.wantErr != nil {
+					assert.Equal(t, tt.wantErr, err)
+				}
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, fileContent)
@@ -515,9 +713,10 @@ func TestGitAddReviewers(t *testing.T) {
 
 func TestGitCreateUpdateMultipleFiles(t *testing.T) {
 	tests := []struct {
-		name      string
-		batch     git.BatchFileUpdate
-		wantError bool
+		name        string
+		batch       git.BatchFileUpdate
+		wantError   bool
+		wantErrType error
 	}{
 		{
 			name: "success - multiple files",
@@ -551,6 +750,42 @@ func TestGitCreateUpdateMultipleFiles(t *testing.T) {
 			},
 			wantError: false,
 		},
+		{
+			name: "success - file modes",
+			batch: git.BatchFileUpdate{
+				Branch:  "main",
+				Message: "Add scripts",
+				Files: []git.FileOperation{
+					{
+						Path:    "run.sh",
+						Content: "#!/bin/sh\necho hello\n",
+						Mode:    git.FileModeExecutable,
+					},
+					{
+						Path:    "latest",
+						Content: "run.sh",
+						Mode:    git.FileModeSymlink,
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "invalid file mode",
+			batch: git.BatchFileUpdate{
+				Branch:  "main",
+				Message: "Add script",
+				Files: []git.FileOperation{
+					{
+						Path:    "run.sh",
+						Content: "echo hello",
+						Mode:    "777",
+					},
+				},
+			},
+			wantError:   true,
+			wantErrType: git.ErrInvalidFileMode{},
+		},
 	}
 
 	for _, tt := range tests {
@@ -595,6 +830,18 @@ func TestGitCreateUpdateMultipleFiles(t *testing.T) {
 
 				// 4. Create new tree
 				case r.URL.Path == "/repos/test-owner/test-repo/git/trees" && r.Method == "POST":
+					var treeReq struct {
+						Tree []git.TreeEntry `json:"tree"`
+					}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&treeReq))
+					assert.Len(t, treeReq.Tree, len(tt.batch.Files))
+					for i, entry := range treeReq.Tree {
+						wantMode := tt.batch.Files[i].Mode
+						if wantMode == "" {
+							wantMode = git.FileModeRegular
+						}
+						assert.Equal(t, wantMode, entry.Mode)
+					}
 					w.WriteHeader(http.StatusCreated)
 					w.Write([]byte(`{
 						"sha": "new-tree-sha",
@@ -638,11 +885,2280 @@ func TestGitCreateUpdateMultipleFiles(t *testing.T) {
 			)
 
 			err := client.CreateUpdateMultipleFiles(tt.batch)
+			if tt.wantError {
+				assert.Error(t, err)
+				if tt.wantErrType != nil {
+					assert.IsType(t, tt.wantErrType, err)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type recordingLogger struct {
+	entries [][]any
+}
+
+func (l *recordingLogger) Debug(msg string, keysAndValues ...any) {
+	l.entries = append(l.entries, append([]any{msg}, keysAndValues...))
+}
+
+func (l *recordingLogger) Error(msg string, keysAndValues ...any) {
+	l.entries = append(l.entries, append([]any{msg}, keysAndValues...))
+}
+
+func TestGitLoggerAndMetrics(t *testing.T) {
+	server := setupMockServer(
+		t,
+		"/repos/test-owner/test-repo/git/refs/heads/main",
+		http.MethodGet,
+		http.StatusOK,
+		[]byte(`{"ref": "refs/heads/main", "object": {"sha": "test-sha"}}`),
+	)
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	var (
+		gotMethod string
+		gotStatus int
+		calls     int
+	)
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+		git.WithLogger(logger),
+		git.WithMetrics(func(method string, status int, dur time.Duration) {
+			gotMethod = method
+			gotStatus = status
+			calls++
+		}),
+	)
+
+	_, err := client.GetBranch("main")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.MethodGet, gotMethod)
+	assert.Equal(t, http.StatusOK, gotStatus)
+	assert.Len(t, logger.entries, 1)
+	assert.NotContains(t, fmt.Sprint(logger.entries), "test-token")
+}
+
+func TestGitGetFileAs(t *testing.T) {
+	type config struct {
+		Name    string `json:"name" yaml:"name"`
+		Retries int    `json:"retries" yaml:"retries"`
+	}
+
+	tests := []struct {
+		name      string
+		filePath  string
+		content   string
+		wantError bool
+	}{
+		{
+			name:     "json file",
+			filePath: "config.json",
+			content:  `{"name": "service", "retries": 3}`,
+		},
+		{
+			name:     "yaml file",
+			filePath: "config.yaml",
+			content:  "name: service\nretries: 3\n",
+		},
+		{
+			name:      "unsupported extension",
+			filePath:  "config.toml",
+			content:   `name = "service"`,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, _ := json.Marshal(map[string]string{
+				"name":     tt.filePath,
+				"path":     tt.filePath,
+				"encoding": "base64",
+				"content":  base64.StdEncoding.EncodeToString([]byte(tt.content)),
+			})
+			server := setupMockServer(
+				t,
+				"/repos/test-owner/test-repo/contents/"+tt.filePath,
+				http.MethodGet,
+				http.StatusOK,
+				response,
+			)
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			cfg, err := git.GetFileAs[config](client, "main", tt.filePath)
 			if tt.wantError {
 				assert.Error(t, err)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, config{Name: "service", Retries: 3}, cfg)
+			}
+		})
+	}
+}
+
+func TestGitRecorder(t *testing.T) {
+	dir := t.TempDir()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "test-sha"}}`))
+	}))
+
+	newClient := func(mode git.RecorderMode) git.IGit {
+		return git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+			git.WithRecorder(dir, mode),
+		)
+	}
+
+	// record
+	branchInfo, err := newClient(git.RecordMode).GetBranch("main")
+	assert.NoError(t, err)
+	assert.Equal(t, "test-sha", branchInfo.Object.Sha)
+	assert.Equal(t, 1, calls)
+
+	fixtures, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, fixtures, 1)
+	data, err := os.ReadFile(filepath.Join(dir, fixtures[0].Name()))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "test-token")
+
+	// record again hits the server
+	_, err = newClient(git.RecordMode).GetBranch("main")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	// replay without hitting the server
+	branchInfo, err = newClient(git.ReplayMode).GetBranch("main")
+	assert.NoError(t, err)
+	assert.Equal(t, "test-sha", branchInfo.Object.Sha)
+	assert.Equal(t, 2, calls)
+
+	// replay of a request without a fixture fails without hitting the server
+	_, err = newClient(git.ReplayMode).GetBranch("develop")
+	assert.ErrorAs(t, err, &git.ErrFixtureNotFound{})
+	assert.Equal(t, 2, calls)
+
+}
+
+func TestGitAPIVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []git.Option
+		version string
+	}{
+		{
+			name:    "version set",
+			opts:    []git.Option{git.WithAPIVersion("2022-11-28")},
+			version: "2022-11-28",
+		},
+		{
+			name: "version not set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.version, r.Header.Get("X-GitHub-Api-Version"))
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			client := git.New(append([]git.Option{
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			}, tt.opts...)...)
+
+			_, err := client.CreateBranch("feature", "abc123")
+			assert.NoError(t, err)
+		})
+	}
+
+	assert.Panics(t, func() { git.WithAPIVersion("") })
+}
+
+func TestGitGetRateLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  []byte
+		status    int
+		wantError bool
+	}{
+		{
+			name: "success",
+			response: []byte(`{
+				"resources": {
+					"core": {"limit": 5000, "remaining": 4999, "reset": 1700000000, "used": 1},
+					"search": {"limit": 30, "remaining": 18, "reset": 1700000060, "used": 12},
+					"graphql": {"limit": 5000, "remaining": 4993, "reset": 1700000120, "used": 7}
+				},
+				"rate": {"limit": 5000, "remaining": 4999, "reset": 1700000000, "used": 1}
+			}`),
+			status:    http.StatusOK,
+			wantError: false,
+		},
+		{
+			name:      "unauthorized",
+			status:    http.StatusUnauthorized,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupMockServer(t, "/rate_limit", http.MethodGet, tt.status, tt.response)
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			rateLimit, err := client.GetRateLimit()
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.Nil(t, rateLimit)
+				return
 			}
+			assert.NoError(t, err)
+			assert.Equal(t, git.Rate{Limit: 5000, Remaining: 4999, Reset: time.Unix(1700000000, 0)}, rateLimit.Core)
+			assert.Equal(t, git.Rate{Limit: 30, Remaining: 18, Reset: time.Unix(1700000060, 0)}, rateLimit.Search)
+			assert.Equal(t, git.Rate{Limit: 5000, Remaining: 4993, Reset: time.Unix(1700000120, 0)}, rateLimit.GraphQL)
+		})
+	}
+}
+
+func TestGitDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token test-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/vnd.github+json", r.Header.Get("Accept"))
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/repos/test-owner/test-repo/labels", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]string{"name": "bug", "color": "f29513"}, body)
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 1, "name": "bug"}`))
+	}))
+	defer server.Close()
+
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+	)
+
+	resp, err := client.Do(
+		http.MethodPost,
+		"/repos/test-owner/test-repo/labels?per_page=100",
+		map[string]string{"name": "bug", "color": "f29513"},
+	)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var label struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&label))
+	assert.Equal(t, "bug", label.Name)
+
+	_, err = client.Do(http.MethodPost, "repos/test-owner/test-repo/labels", make(chan int))
+	assert.Error(t, err)
+}
+
+func TestGitCreateUpdateMultipleFilesBinary(t *testing.T) {
+	// A 1x1 transparent PNG
+	png, err := base64.StdEncoding.DecodeString(
+		"iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII=",
+	)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name string
+		file git.FileOperation
+	}{
+		{
+			name: "marked as binary",
+			file: git.FileOperation{Path: "logo.png", Content: string(png), Binary: true},
+		},
+		{
+			name: "detected as binary",
+			file: git.FileOperation{Path: "logo.png", Content: string(png)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobs := map[string]string{}
+			var treeSha string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.URL.Path == "/repos/test-owner/test-repo/git/refs/heads/main" && r.Method == http.MethodGet:
+					w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "commit-sha", "type": "commit"}}`))
+				case r.URL.Path == "/repos/test-owner/test-repo/git/commits/commit-sha" && r.Method == http.MethodGet:
+					w.Write([]byte(`{"sha": "commit-sha", "tree": {"sha": "tree-sha"}}`))
+				case r.URL.Path == "/repos/test-owner/test-repo/git/blobs" && r.Method == http.MethodPost:
+					var blobReq map[string]string
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&blobReq))
+					assert.Equal(t, "base64", blobReq["encoding"])
+					content, err := base64.StdEncoding.DecodeString(blobReq["content"])
+					assert.NoError(t, err)
+					blobs["blob-sha"] = string(content)
+					w.WriteHeader(http.StatusCreated)
+					w.Write([]byte(`{"sha": "blob-sha"}`))
+				case r.URL.Path == "/repos/test-owner/test-repo/git/trees" && r.Method == http.MethodPost:
+					var treeReq struct {
+						Tree []git.TreeEntry `json:"tree"`
+					}
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&treeReq))
+					treeSha = treeReq.Tree[0].Sha
+					w.WriteHeader(http.StatusCreated)
+					w.Write([]byte(`{"sha": "new-tree-sha"}`))
+				case r.URL.Path == "/repos/test-owner/test-repo/git/commits" && r.Method == http.MethodPost:
+					w.WriteHeader(http.StatusCreated)
+					w.Write([]byte(`{"sha": "new-commit-sha"}`))
+				case r.URL.Path == "/repos/test-owner/test-repo/git/refs/heads/main" && r.Method == http.MethodPatch:
+					w.Write([]byte(`{"ref": "refs/heads/main"}`))
+				case r.URL.Path == "/repos/test-owner/test-repo/contents/logo.png" && r.Method == http.MethodGet:
+					response, _ := json.Marshal(map[string]string{
+						"name":     "logo.png",
+						"path":     "logo.png",
+						"encoding": "base64",
+						"content":  base64.StdEncoding.EncodeToString([]byte(blobs[treeSha])),
+					})
+					w.Write(response)
+				default:
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			err := client.CreateUpdateMultipleFiles(git.BatchFileUpdate{
+				Branch:  "main",
+				Message: "Add logo",
+				Files:   []git.FileOperation{tt.file},
+			})
+			assert.NoError(t, err)
+
+			fileInfo, err := client.GetAFile("main", "logo.png")
+			assert.NoError(t, err)
+			content, err := base64.StdEncoding.DecodeString(fileInfo.Content)
+			assert.NoError(t, err)
+			assert.Equal(t, png, content)
 		})
 	}
 }
+
+func TestGitEnsureBranch(t *testing.T) {
+	branchResponse := func(branch string, sha string) []byte {
+		return []byte(`{"ref": "refs/heads/` + branch + `", "object": {"sha": "` + sha + `", "type": "commit"}}`)
+	}
+
+	tests := []struct {
+		name         string
+		exists       bool
+		baseExists   bool
+		createStatus int
+		wantSha      string
+		wantError    error
+	}{
+		{
+			name:       "branch exists",
+			exists:     true,
+			baseExists: true,
+			wantSha:    "feature-sha",
+		},
+		{
+			name:         "branch created",
+			baseExists:   true,
+			createStatus: http.StatusCreated,
+			wantSha:      "main-sha",
+		},
+		{
+			name:         "branch created concurrently",
+			baseExists:   true,
+			createStatus: http.StatusUnprocessableEntity,
+			wantSha:      "feature-sha",
+		},
+		{
+			name:      "base branch not found",
+			wantError: git.ErrBranchNotFound{Value: "main"},
+		},
+		{
+			name:         "create fails",
+			baseExists:   true,
+			createStatus: http.StatusForbidden,
+			wantError:    git.ErrFailedToCreateBranch{Value: "feature: 403 Forbidden"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exists := tt.exists
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/repos/test-owner/test-repo/git/refs/heads/feature" && r.Method == http.MethodGet:
+					if !exists {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.Write(branchResponse("feature", "feature-sha"))
+				case r.URL.Path == "/repos/test-owner/test-repo/git/refs/heads/main" && r.Method == http.MethodGet:
+					if !tt.baseExists {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.Write(branchResponse("main", "main-sha"))
+				case r.URL.Path == "/repos/test-owner/test-repo/git/refs" && r.Method == http.MethodPost:
+					var reqBody map[string]string
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+					assert.Equal(t, "refs/heads/feature", reqBody["ref"])
+					assert.Equal(t, "main-sha", reqBody["sha"])
+					if tt.createStatus == http.StatusUnprocessableEntity {
+						exists = true
+					}
+					w.WriteHeader(tt.createStatus)
+					if tt.createStatus == http.StatusCreated {
+						w.Write(branchResponse("feature", "main-sha"))
+					}
+				default:
+					t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			branchInfo, err := client.EnsureBranch("feature", "main")
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+				assert.Nil(t, branchInfo)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "refs/heads/feature", branchInfo.Ref)
+			assert.Equal(t, tt.wantSha, branchInfo.Object.Sha)
+		})
+	}
+}
+
+func TestGitAssignees(t *testing.T) {
+	tests := []struct {
+		name       string
+		remove     bool
+		assignees  []string
+		status     int
+		response   []byte
+		wantError  error
+		wantErrors []string
+	}{
+		{
+			name:      "add assignees",
+			assignees: []string{"octocat", "hubot"},
+			status:    http.StatusCreated,
+			response:  []byte(`{"number": 42, "assignees": [{"login": "octocat"}, {"login": "hubot"}]}`),
+		},
+		{
+			name:      "remove assignees",
+			remove:    true,
+			assignees: []string{"octocat"},
+			status:    http.StatusOK,
+			response:  []byte(`{"number": 42, "assignees": []}`),
+		},
+		{
+			name:      "no assignees",
+			wantError: git.ErrInvalidAssignees{},
+		},
+		{
+			name:      "empty assignee",
+			remove:    true,
+			assignees: []string{"octocat", ""},
+			wantError: git.ErrInvalidAssignees{},
+		},
+		{
+			name:      "invalid username",
+			assignees: []string{"not a user"},
+			status:    http.StatusUnprocessableEntity,
+			response: []byte(`{
+				"message": "Validation Failed",
+				"errors": ["not a user is not a valid username"],
+				"documentation_url": "https://docs.github.com/rest/issues/assignees#add-assignees-to-an-issue"
+			}`),
+			wantError:  git.ErrGitHubAPI{},
+			wantErrors: []string{"not a user is not a valid username"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/test-owner/test-repo/issues/42/assignees", r.URL.Path)
+				if tt.remove {
+					assert.Equal(t, http.MethodDelete, r.Method)
+				} else {
+					assert.Equal(t, http.MethodPost, r.Method)
+				}
+
+				var reqBody map[string][]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.Equal(t, tt.assignees, reqBody["assignees"])
+
+				w.WriteHeader(tt.status)
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			var err error
+			if tt.remove {
+				err = client.RemoveAssignees(42, tt.assignees)
+			} else {
+				err = client.AddAssignees(42, tt.assignees)
+			}
+			if tt.wantError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.IsType(t, tt.wantError, err)
+			if apiErr, ok := err.(git.ErrGitHubAPI); ok {
+				assert.Equal(t, tt.status, apiErr.StatusCode)
+				assert.Equal(t, "Validation Failed", apiErr.Message)
+				assert.Equal(t, tt.wantErrors, apiErr.Errors)
+			}
+		})
+	}
+}
+
+func TestGitSubmitReview(t *testing.T) {
+	tests := []struct {
+		name       string
+		event      string
+		body       string
+		status     int
+		response   []byte
+		wantError  error
+		wantErrors []string
+	}{
+		{
+			name:     "approve",
+			event:    git.ReviewEventApprove,
+			status:   http.StatusOK,
+			response: []byte(`{"id": 80, "state": "APPROVED"}`),
+		},
+		{
+			name:     "request changes",
+			event:    git.ReviewEventRequestChanges,
+			body:     "Please add tests",
+			status:   http.StatusOK,
+			response: []byte(`{"id": 81, "state": "CHANGES_REQUESTED"}`),
+		},
+		{
+			name:      "invalid event",
+			event:     "MERGE",
+			wantError: git.ErrInvalidReviewEvent{},
+		},
+		{
+			name:   "self approval",
+			event:  git.ReviewEventApprove,
+			status: http.StatusUnprocessableEntity,
+			response: []byte(`{
+				"message": "Unprocessable Entity",
+				"errors": ["Can not approve your own pull request"],
+				"documentation_url": "https://docs.github.com/rest/pulls/reviews#create-a-review-for-a-pull-request"
+			}`),
+			wantError:  git.ErrGitHubAPI{},
+			wantErrors: []string{"Can not approve your own pull request"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/test-owner/test-repo/pulls/42/reviews", r.URL.Path)
+				assert.Equal(t, http.MethodPost, r.Method)
+
+				var reqBody map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.Equal(t, tt.event, reqBody["event"])
+				assert.Equal(t, tt.body, reqBody["body"])
+
+				w.WriteHeader(tt.status)
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			err := client.SubmitReview(42, tt.event, tt.body)
+			if tt.wantError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.IsType(t, tt.wantError, err)
+			if apiErr, ok := err.(git.ErrGitHubAPI); ok {
+				assert.Equal(t, tt.status, apiErr.StatusCode)
+				assert.Equal(t, "Unprocessable Entity", apiErr.Message)
+				assert.Equal(t, tt.wantErrors, apiErr.Errors)
+			}
+		})
+	}
+}
+
+func TestGitListReviews(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/test-owner/test-repo/pulls/42/reviews", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Link", fmt.Sprintf(
+				`<%s/repositories/1/pulls/42/reviews?per_page=100&page=2>; rel="next", <%s/repositories/1/pulls/42/reviews?per_page=100&page=2>; rel="last"`,
+				server.URL, server.URL,
+			))
+			w.Write([]byte(`[{"id": 1, "user": {"login": "alice", "id": 10}, "state": "COMMENTED", "submitted_at": "2024-01-02T03:04:05Z"}]`))
+		case "2":
+			w.Header().Set("Link", fmt.Sprintf(
+				`<%s/repositories/1/pulls/42/reviews?per_page=100&page=1>; rel="prev", <%s/repositories/1/pulls/42/reviews?per_page=100&page=1>; rel="first"`,
+				server.URL, server.URL,
+			))
+			w.Write([]byte(`[{"id": 2, "user": {"login": "bob", "id": 11}, "state": "APPROVED", "submitted_at": "2024-01-03T03:04:05Z"}]`))
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	}))
+	defer server.Close()
+
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+	)
+
+	reviews, err := client.ListReviews(42)
+	assert.NoError(t, err)
+	assert.Equal(t, []git.Review{
+		{
+			ID:          1,
+			User:        git.User{Login: "alice", ID: 10},
+			State:       "COMMENTED",
+			SubmittedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		{
+			ID:          2,
+			User:        git.User{Login: "bob", ID: 11},
+			State:       "APPROVED",
+			SubmittedAt: time.Date(2024, 1, 3, 3, 4, 5, 0, time.UTC),
+		},
+	}, reviews)
+
+	t.Run("not found", func(t *testing.T) {
+		server := setupMockServer(t, "/repos/test-owner/test-repo/pulls/42/reviews", http.MethodGet, http.StatusNotFound, nil)
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		reviews, err := client.ListReviews(42)
+		assert.Error(t, err)
+		assert.Nil(t, reviews)
+	})
+}
+
+func TestGitCreateRepositoryDispatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		payload   map[string]interface{}
+		status    int
+		response  []byte
+		wantError error
+	}{
+		{
+			name:      "success",
+			eventType: "deploy",
+			payload:   map[string]interface{}{"environment": "staging"},
+			status:    http.StatusNoContent,
+		},
+		{
+			name:      "empty event type",
+			eventType: "",
+			wantError: git.ErrInvalidEventType{},
+		},
+		{
+			name:      "not found",
+			eventType: "deploy",
+			status:    http.StatusNotFound,
+			response:  []byte(`{"message": "Not Found", "documentation_url": "https://docs.github.com/rest"}`),
+			wantError: git.ErrGitHubAPI{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/test-owner/test-repo/dispatches", r.URL.Path)
+				assert.Equal(t, http.MethodPost, r.Method)
+
+				var reqBody struct {
+					EventType     string                 `json:"event_type"`
+					ClientPayload map[string]interface{} `json:"client_payload"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.Equal(t, tt.eventType, reqBody.EventType)
+				assert.Equal(t, tt.payload, reqBody.ClientPayload)
+
+				w.WriteHeader(tt.status)
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			err := client.CreateRepositoryDispatch(tt.eventType, tt.payload)
+			if tt.wantError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.IsType(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestGitTriggerWorkflow(t *testing.T) {
+	tests := []struct {
+		name      string
+		workflow  string
+		ref       string
+		inputs    map[string]string
+		status    int
+		response  []byte
+		wantError error
+	}{
+		{
+			name:     "success",
+			workflow: "deploy.yml",
+			ref:      "main",
+			inputs:   map[string]string{"environment": "staging"},
+			status:   http.StatusNoContent,
+		},
+		{
+			name:     "success without inputs",
+			workflow: "deploy.yml",
+			ref:      "v1.0.0",
+			status:   http.StatusNoContent,
+		},
+		{
+			name:      "empty ref",
+			workflow:  "deploy.yml",
+			wantError: git.ErrInvalidRef{},
+		},
+		{
+			name:      "empty workflow",
+			ref:       "main",
+			wantError: git.ErrInvalidWorkflow{},
+		},
+		{
+			name:      "unexpected input",
+			workflow:  "deploy.yml",
+			ref:       "main",
+			inputs:    map[string]string{"unknown": "value"},
+			status:    http.StatusUnprocessableEntity,
+			response:  []byte(`{"message": "Unexpected inputs provided: [\"unknown\"]"}`),
+			wantError: git.ErrGitHubAPI{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/test-owner/test-repo/actions/workflows/deploy.yml/dispatches", r.URL.Path)
+				assert.Equal(t, http.MethodPost, r.Method)
+
+				var reqBody struct {
+					Ref    string            `json:"ref"`
+					Inputs map[string]string `json:"inputs"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.Equal(t, tt.ref, reqBody.Ref)
+				assert.Equal(t, tt.inputs, reqBody.Inputs)
+
+				w.WriteHeader(tt.status)
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			err := client.TriggerWorkflow(tt.workflow, tt.ref, tt.inputs)
+			if tt.wantError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.IsType(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestGitListWorkflowRuns(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/test-owner/test-repo/actions/workflows/deploy.yml/runs", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "main", r.URL.Query().Get("branch"))
+		assert.Equal(t, "completed", r.URL.Query().Get("status"))
+		assert.False(t, r.URL.Query().Has("event"))
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repositories/1/actions/workflows/deploy.yml/runs?page=2>; rel="next"`, server.URL))
+			w.Write([]byte(`{"total_count": 2, "workflow_runs": [
+				{"id": 2, "head_sha": "def456", "status": "completed", "conclusion": "failure", "html_url": "https://github.com/test-owner/test-repo/actions/runs/2"}
+			]}`))
+		case "2":
+			w.Write([]byte(`{"total_count": 2, "workflow_runs": [
+				{"id": 1, "head_sha": "abc123", "status": "completed", "conclusion": "success", "html_url": "https://github.com/test-owner/test-repo/actions/runs/1"}
+			]}`))
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	}))
+	defer server.Close()
+
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+	)
+
+	runs, err := client.ListWorkflowRuns("deploy.yml", git.WorkflowRunOptions{Branch: "main", Status: "completed"})
+	assert.NoError(t, err)
+	assert.Equal(t, []git.WorkflowRun{
+		{
+			ID:         2,
+			HeadSha:    "def456",
+			Status:     "completed",
+			Conclusion: "failure",
+			HTMLURL:    "https://github.com/test-owner/test-repo/actions/runs/2",
+		},
+		{
+			ID:         1,
+			HeadSha:    "abc123",
+			Status:     "completed",
+			Conclusion: "success",
+			HTMLURL:    "https://github.com/test-owner/test-repo/actions/runs/1",
+		},
+	}, runs)
+
+	t.Run("max runs", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			assert.Equal(t, "2", r.URL.Query().Get("per_page"))
+			w.Header().Set("Link", `<https://api.github.com/repositories/1/actions/workflows/deploy.yml/runs?page=2>; rel="next"`)
+			w.Write([]byte(`{"total_count": 500, "workflow_runs": [{"id": 3}, {"id": 2}]}`))
+		}))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		runs, err := client.ListWorkflowRuns("deploy.yml", git.WorkflowRunOptions{MaxRuns: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, []git.WorkflowRun{{ID: 3}, {ID: 2}}, runs)
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("empty workflow", func(t *testing.T) {
+		runs, err := client.ListWorkflowRuns("", git.WorkflowRunOptions{})
+		assert.IsType(t, git.ErrInvalidWorkflow{}, err)
+		assert.Nil(t, runs)
+	})
+}
+
+func TestGitCreateStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     string
+		status    int
+		response  []byte
+		wantError error
+	}{
+		{
+			name:     "success",
+			state:    git.StatusStateSuccess,
+			status:   http.StatusCreated,
+			response: []byte(`{"id": 1, "state": "success", "context": "ci/lint"}`),
+		},
+		{
+			name:      "invalid state",
+			state:     "done",
+			wantError: git.ErrInvalidStatusState{},
+		},
+		{
+			name:      "unknown commit",
+			state:     git.StatusStatePending,
+			status:    http.StatusUnprocessableEntity,
+			response:  []byte(`{"message": "No commit found for SHA: abc123"}`),
+			wantError: git.ErrGitHubAPI{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/test-owner/test-repo/statuses/abc123", r.URL.Path)
+				assert.Equal(t, http.MethodPost, r.Method)
+
+				var reqBody map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.Equal(t, map[string]string{
+					"state":       tt.state,
+					"target_url":  "https://ci.example.com/builds/1",
+					"description": "Lint",
+					"context":     "ci/lint",
+				}, reqBody)
+
+				w.WriteHeader(tt.status)
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			err := client.CreateStatus("abc123", tt.state, "https://ci.example.com/builds/1", "Lint", "ci/lint")
+			if tt.wantError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.IsType(t, tt.wantError, err)
+			if apiErr, ok := err.(git.ErrGitHubAPI); ok {
+				assert.Equal(t, "No commit found for SHA: abc123", apiErr.Message)
+			}
+		})
+	}
+}
+
+func TestGitGetContents(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		status      int
+		response    []byte
+		wantType    string
+		wantContent []byte
+		wantEntries []string
+		wantError   bool
+	}{
+		{
+			name:   "file",
+			path:   "config/app.yaml",
+			status: http.StatusOK,
+			response: []byte(fmt.Sprintf(
+				`{"name": "app.yaml", "path": "config/app.yaml", "type": "file", "encoding": "base64", "content": %q}`,
+				base64.StdEncoding.EncodeToString([]byte("name: app\n")),
+			)),
+			wantType:    git.ContentTypeFile,
+			wantContent: []byte("name: app\n"),
+		},
+		{
+			name:   "directory",
+			path:   "config",
+			status: http.StatusOK,
+			response: []byte(`[
+				{"name": "app.yaml", "path": "config/app.yaml", "type": "file"},
+				{"name": "env", "path": "config/env", "type": "dir"}
+			]`),
+			wantType:    git.ContentTypeDir,
+			wantEntries: []string{"config/app.yaml", "config/env"},
+		},
+		{
+			name:      "not found",
+			path:      "missing",
+			status:    http.StatusNotFound,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/test-owner/test-repo/contents/"+tt.path, r.URL.Path)
+				assert.Equal(t, "main", r.URL.Query().Get("ref"))
+				w.WriteHeader(tt.status)
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			contents, err := client.GetContents("main", tt.path)
+			if tt.wantError {
+				assert.Equal(t, git.ErrFileNotFound{Value: tt.path}, err)
+				assert.Nil(t, contents)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantType, contents.Type)
+			assert.Equal(t, tt.wantContent, contents.Content)
+			var entries []string
+			for _, entry := range contents.Entries {
+				entries = append(entries, entry.Path)
+			}
+			assert.Equal(t, tt.wantEntries, entries)
+		})
+	}
+}
+
+func TestGitCreateBlob(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     []byte
+		encoding    string
+		wantContent string
+		status      int
+		wantError   error
+	}{
+		{
+			name:        "utf-8",
+			content:     []byte("replicas: 2"),
+			encoding:    git.BlobEncodingUTF8,
+			wantContent: "replicas: 2",
+			status:      http.StatusCreated,
+		},
+		{
+			name:        "base64",
+			content:     []byte{0x89, 0x50, 0x4e, 0x47},
+			encoding:    git.BlobEncodingBase64,
+			wantContent: "iVBORw==",
+			status:      http.StatusCreated,
+		},
+		{
+			name:      "invalid encoding",
+			content:   []byte("replicas: 2"),
+			encoding:  "latin1",
+			wantError: git.ErrInvalidEncoding{Value: "latin1"},
+		},
+		{
+			name:      "invalid utf-8",
+			content:   []byte{0xff, 0xfe},
+			encoding:  git.BlobEncodingUTF8,
+			wantError: git.ErrInvalidEncoding{Value: "content is not valid utf-8"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/repos/test-owner/test-repo/git/blobs", r.URL.Path)
+				var blobReq map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&blobReq))
+				assert.Equal(t, map[string]string{"content": tt.wantContent, "encoding": tt.encoding}, blobReq)
+				w.WriteHeader(tt.status)
+				w.Write([]byte(`{"sha": "blob-sha", "url": "https://api.github.com/repos/test-owner/test-repo/git/blobs/blob-sha"}`))
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			blob, err := client.CreateBlob(tt.content, tt.encoding)
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+				assert.Nil(t, blob)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "blob-sha", blob.Sha)
+		})
+	}
+}
+
+func TestGitGetBlob(t *testing.T) {
+	sha := "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15"
+	tests := []struct {
+		name      string
+		sha       string
+		status    int
+		response  []byte
+		want      []byte
+		wantError error
+	}{
+		{
+			name:     "base64",
+			sha:      sha,
+			status:   http.StatusOK,
+			response: []byte(`{"sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15", "size": 13, "encoding": "base64", "content": "aGVsbG8s\nIHdvcmxkIQ==\n"}`),
+			want:     []byte("hello, world!"),
+		},
+		{
+			name:     "utf-8",
+			sha:      sha,
+			status:   http.StatusOK,
+			response: []byte(`{"sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15", "size": 5, "encoding": "utf-8", "content": "hello"}`),
+			want:     []byte("hello"),
+		},
+		{
+			name:      "unknown encoding",
+			sha:       sha,
+			status:    http.StatusOK,
+			response:  []byte(`{"sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15", "size": 5, "encoding": "latin1", "content": "hello"}`),
+			wantError: git.ErrInvalidEncoding{Value: "latin1"},
+		},
+		{
+			name:      "short sha",
+			sha:       "3a0f86f",
+			wantError: git.ErrInvalidSHA{},
+		},
+		{
+			name:      "not found",
+			sha:       sha,
+			status:    http.StatusNotFound,
+			response:  []byte(`{"message": "Not Found"}`),
+			wantError: git.ErrGitHubAPI{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupMockServer(t, "/repos/test-owner/test-repo/git/blobs/"+tt.sha, http.MethodGet, tt.status, tt.response)
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			content, err := client.GetBlob(tt.sha)
+			if tt.wantError != nil {
+				assert.IsType(t, tt.wantError, err)
+				assert.Nil(t, content)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, content)
+		})
+	}
+
+	t.Run("large file", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/repos/test-owner/test-repo/contents/data/large.json":
+				w.Write([]byte(`{"type": "file", "path": "data/large.json", "sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15", "size": 2097152, "encoding": "none", "content": ""}`))
+			case "/repos/test-owner/test-repo/git/blobs/" + sha:
+				w.Write([]byte(`{"sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15", "size": 15, "encoding": "base64", "content": "eyJsYXJnZSI6IHRydWV9"}`))
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+		}))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		file, err := client.GetAFile("main", "data/large.json")
+		assert.NoError(t, err)
+		assert.Equal(t, "base64", file.Encoding)
+		assert.Equal(t, "eyJsYXJnZSI6IHRydWV9", file.Content)
+
+		decoded, err := git.GetFileAs[map[string]bool](client, "main", "data/large.json")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]bool{"large": true}, decoded)
+
+		contents, err := client.GetContents("main", "data/large.json")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{"large": true}`), contents.Content)
+	})
+}
+
+func TestGitCreateTreeAndCommit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var req map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		switch r.URL.Path {
+		case "/repos/test-owner/test-repo/git/trees":
+			if req["base_tree"] == "missing-tree-sha" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message": "base_tree is not a valid tree oid"}`))
+				return
+			}
+			assert.Equal(t, "base-tree-sha", req["base_tree"])
+			assert.Equal(t, []interface{}{map[string]interface{}{
+				"path": "assets/logo.png", "mode": "100644", "type": "blob", "sha": "blob-sha",
+			}}, req["tree"])
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"sha": "new-tree-sha"}`))
+		case "/repos/test-owner/test-repo/git/commits":
+			assert.Equal(t, "Update logo", req["message"])
+			assert.Equal(t, "new-tree-sha", req["tree"])
+			assert.Equal(t, []interface{}{"parent-sha"}, req["parents"])
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"sha": "new-commit-sha", "tree": {"sha": "new-tree-sha"}}`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+	)
+
+	tree, err := client.CreateTree("base-tree-sha", []git.TreeEntry{
+		{Path: "assets/logo.png", Mode: git.FileModeRegular, Type: "blob", Sha: "blob-sha"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "new-tree-sha", tree.Sha)
+
+	commit, err := client.CreateCommit("Update logo", tree.Sha, []string{"parent-sha"})
+	assert.NoError(t, err)
+	assert.Equal(t, "new-commit-sha", commit.Sha)
+	assert.Equal(t, "new-tree-sha", commit.Tree.Sha)
+
+	_, err = client.CreateTree("missing-tree-sha", nil)
+	var apiErr git.ErrGitHubAPI
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+}
+
+func TestGitGetRepository(t *testing.T) {
+	server := setupMockServer(t, "/repos/test-owner/test-repo", http.MethodGet, http.StatusOK, []byte(`{
+		"name": "test-repo",
+		"full_name": "test-owner/test-repo",
+		"description": "Test repository",
+		"default_branch": "main",
+		"private": true,
+		"archived": true,
+		"fork": false,
+		"html_url": "https://github.com/test-owner/test-repo",
+		"permissions": {"admin": false, "maintain": false, "push": true, "triage": true, "pull": true}
+	}`))
+	defer server.Close()
+
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+	)
+
+	repo, err := client.GetRepository()
+	assert.NoError(t, err)
+	assert.Equal(t, &git.Repository{
+		Name:          "test-repo",
+		FullName:      "test-owner/test-repo",
+		Description:   "Test repository",
+		DefaultBranch: "main",
+		Private:       true,
+		Archived:      true,
+		HTMLUrl:       "https://github.com/test-owner/test-repo",
+		Permissions:   git.Permissions{Push: true, Triage: true, Pull: true},
+	}, repo)
+}
+
+func TestGitGetDefaultBranch(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  []byte
+		status    int
+		want      string
+		wantError bool
+	}{
+		{
+			name:     "success",
+			response: []byte(`{"name": "test-repo", "default_branch": "master"}`),
+			status:   http.StatusOK,
+			want:     "master",
+		},
+		{
+			name:      "not found",
+			response:  []byte(`{"message": "Not Found"}`),
+			status:    http.StatusNotFound,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupMockServer(t, "/repos/test-owner/test-repo", http.MethodGet, tt.status, tt.response)
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			branch, err := client.GetDefaultBranch()
+			if tt.wantError {
+				var apiErr git.ErrGitHubAPI
+				assert.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, tt.status, apiErr.StatusCode)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, branch)
+		})
+	}
+}
+
+func TestGitListCommits(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/test-owner/test-repo/commits", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		qs := r.URL.Query()
+		assert.Equal(t, "100", qs.Get("per_page"))
+		assert.Equal(t, "docs/CHANGELOG.md", qs.Get("path"))
+		assert.Equal(t, "main", qs.Get("sha"))
+		assert.Equal(t, "2024-01-01T00:00:00Z", qs.Get("since"))
+		assert.False(t, qs.Has("until"))
+		assert.Equal(t, "octocat", qs.Get("author"))
+
+		switch qs.Get("page") {
+		case "1":
+			w.Header().Set("Link", fmt.Sprintf(
+				`<%s/repositories/1/commits?per_page=100&page=2>; rel="next", <%s/repositories/1/commits?per_page=100&page=2>; rel="last"`,
+				server.URL, server.URL,
+			))
+			w.Write([]byte(`[{
+				"sha": "bbb",
+				"node_id": "C_bbb",
+				"commit": {
+					"author": {"name": "Octo Cat", "email": "octocat@example.com", "date": "2024-01-03T03:04:05Z"},
+					"committer": {"name": "GitHub", "email": "noreply@github.com", "date": "2024-01-03T03:04:05Z"},
+					"message": "docs: update changelog",
+					"tree": {"sha": "tree-bbb", "url": "https://api.github.com/repos/test-owner/test-repo/git/trees/tree-bbb"},
+					"verification": {"verified": true, "reason": "valid"}
+				},
+				"parents": [{"sha": "aaa", "url": "https://api.github.com/repos/test-owner/test-repo/commits/aaa"}]
+			}]`))
+		case "2":
+			w.Write([]byte(`[{
+				"sha": "aaa",
+				"commit": {
+					"author": {"name": "Octo Cat", "email": "octocat@example.com", "date": "2024-01-02T03:04:05Z"},
+					"message": "docs: add changelog",
+					"verification": {"verified": false, "reason": "unsigned"}
+				},
+				"parents": []
+			}]`))
+		default:
+			t.Errorf("unexpected page %q", qs.Get("page"))
+		}
+	}))
+	defer server.Close()
+
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+	)
+
+	commits, err := client.ListCommits(git.CommitListOptions{
+		Path:   "docs/CHANGELOG.md",
+		Sha:    "main",
+		Since:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Author: "octocat",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, commits, 2)
+	assert.Equal(t, "bbb", commits[0].Sha)
+	assert.Equal(t, "C_bbb", commits[0].NodeID)
+	assert.Equal(t, "docs: update changelog", commits[0].Message)
+	assert.Equal(t, "Octo Cat", commits[0].Author.Name)
+	assert.Equal(t, time.Date(2024, 1, 3, 3, 4, 5, 0, time.UTC), commits[0].Author.Date)
+	assert.Equal(t, "GitHub", commits[0].Committer.Name)
+	assert.Equal(t, "tree-bbb", commits[0].Tree.Sha)
+	assert.Len(t, commits[0].Parents, 1)
+	assert.Equal(t, "aaa", commits[0].Parents[0].Sha)
+	assert.True(t, commits[0].Verification.Verified)
+	assert.Equal(t, "aaa", commits[1].Sha)
+	assert.Equal(t, "docs: add changelog", commits[1].Message)
+	assert.Empty(t, commits[1].Parents)
+
+	t.Run("max commits", func(t *testing.T) {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+			w.Header().Set("Link", `<https://api.github.com/repositories/1/commits?per_page=1&page=2>; rel="next"`)
+			w.Write([]byte(`[{"sha": "ccc", "commit": {"message": "fix: latest"}}]`))
+		}))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		commits, err := client.ListCommits(git.CommitListOptions{MaxCommits: 1})
+		assert.NoError(t, err)
+		assert.Len(t, commits, 1)
+		assert.Equal(t, "ccc", commits[0].Sha)
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		server := setupMockServer(t, "/repos/test-owner/test-repo/commits", http.MethodGet, http.StatusNotFound, []byte(`{"message": "Not Found"}`))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		commits, err := client.ListCommits(git.CommitListOptions{})
+		assert.IsType(t, git.ErrGitHubAPI{}, err)
+		assert.Nil(t, commits)
+	})
+}
+
+func TestGitCreatePullRequestDefaultBase(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/test-owner/test-repo":
+			w.Write([]byte(`{"default_branch": "master"}`))
+		case "POST /repos/test-owner/test-repo/pulls":
+			var req map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "master", req["base"])
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"number": 7}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+	)
+
+	number, err := client.CreatePullRequest("", "feature", "title", "description")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, number)
+}
+
+func TestGitUpdatePullRequestBase(t *testing.T) {
+	tests := []struct {
+		name      string
+		newBase   string
+		status    int
+		response  []byte
+		wantError error
+	}{
+		{
+			name:     "success",
+			newBase:  "main",
+			status:   http.StatusOK,
+			response: []byte(`{"number": 42, "base": {"ref": "main"}}`),
+		},
+		{
+			name:      "empty base",
+			newBase:   "",
+			wantError: git.ErrInvalidRef{Value: "base branch is empty"},
+		},
+		{
+			name:    "unknown base",
+			newBase: "gone",
+			status:  http.StatusUnprocessableEntity,
+			response: []byte(`{
+				"message": "Validation Failed",
+				"errors": [{"resource": "PullRequest", "field": "base", "code": "invalid", "message": "Proposed base branch 'gone' was not found"}],
+				"documentation_url": "https://docs.github.com/rest/pulls/pulls#update-a-pull-request"
+			}`),
+			wantError: git.ErrInvalidBase{Value: "gone: Proposed base branch 'gone' was not found"},
+		},
+		{
+			name:      "same base",
+			newBase:   "feature",
+			status:    http.StatusUnprocessableEntity,
+			response:  []byte(`{"message": "Cannot change the base branch to the same branch"}`),
+			wantError: git.ErrInvalidBase{Value: "feature: Cannot change the base branch to the same branch"},
+		},
+		{
+			name:      "missing pull request",
+			newBase:   "main",
+			status:    http.StatusNotFound,
+			response:  []byte(`{"message": "Not Found"}`),
+			wantError: git.ErrGitHubAPI{StatusCode: http.StatusNotFound, Message: "Not Found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/test-owner/test-repo/pulls/42", r.URL.Path)
+				assert.Equal(t, http.MethodPatch, r.Method)
+
+				var reqBody map[string]string
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.Equal(t, map[string]string{"base": tt.newBase}, reqBody)
+
+				w.WriteHeader(tt.status)
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			err := client.UpdatePullRequestBase(42, tt.newBase)
+			if tt.wantError == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantError, err)
+		})
+	}
+}
+
+func TestGitGetCommitVerification(t *testing.T) {
+	tests := []struct {
+		name      string
+		sha       string
+		response  []byte
+		status    int
+		want      *git.Verification
+		wantError bool
+	}{
+		{
+			name: "verified",
+			sha:  "commit-sha",
+			response: []byte(`{
+				"sha": "commit-sha",
+				"verification": {
+					"verified": true,
+					"reason": "valid",
+					"signature": "-----BEGIN PGP SIGNATURE-----",
+					"payload": "tree tree-sha"
+				}
+			}`),
+			status: http.StatusOK,
+			want: &git.Verification{
+				Verified:  true,
+				Reason:    "valid",
+				Signature: "-----BEGIN PGP SIGNATURE-----",
+				Payload:   "tree tree-sha",
+			},
+		},
+		{
+			name: "unsigned",
+			sha:  "commit-sha",
+			response: []byte(`{
+				"sha": "commit-sha",
+				"verification": {"verified": false, "reason": "unsigned", "signature": null, "payload": null}
+			}`),
+			status: http.StatusOK,
+			want:   &git.Verification{Reason: "unsigned"},
+		},
+		{
+			name:      "not found",
+			sha:       "commit-sha",
+			response:  []byte(`{"message": "Not Found"}`),
+			status:    http.StatusNotFound,
+			wantError: true,
+		},
+		{
+			name:      "empty sha",
+			sha:       "",
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupMockServer(
+				t,
+				"/repos/test-owner/test-repo/git/commits/commit-sha",
+				http.MethodGet,
+				tt.status,
+				tt.response,
+			)
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			verification, err := client.GetCommitVerification(tt.sha)
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.Nil(t, verification)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, verification)
+		})
+	}
+}
+
+func TestGitSearchIssues(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/search/issues", r.URL.Path)
+			assert.Equal(t, "repo:test-owner/test-repo is:pr is:open", r.URL.Query().Get("q"))
+			assert.Equal(t, "created", r.URL.Query().Get("sort"))
+			assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+			w.Write([]byte(`{
+				"total_count": 101,
+				"incomplete_results": false,
+				"items": [{
+					"number": 7,
+					"title": "Bump dependencies",
+					"state": "open",
+					"html_url": "https://github.com/test-owner/test-repo/pull/7",
+					"user": {"login": "my-bot[bot]", "id": 1}
+				}]
+			}`))
+		}))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		result, err := client.SearchIssues("repo:test-owner/test-repo is:pr is:open", git.SearchOptions{
+			Sort: "created",
+			Page: 2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, &git.SearchResult{
+			TotalCount: 101,
+			Items: []git.SearchIssue{{
+				Number:  7,
+				Title:   "Bump dependencies",
+				State:   "open",
+				HTMLURL: "https://github.com/test-owner/test-repo/pull/7",
+				User:    git.User{Login: "my-bot[bot]", ID: 1},
+			}},
+		}, result)
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "1700000000")
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+		}))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		result, err := client.SearchIssues("is:pr", git.SearchOptions{})
+		assert.Nil(t, result)
+		assert.Equal(t, git.ErrRateLimited{Reset: time.Unix(1700000000, 0)}, err)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"message": "Validation Failed", "errors": [{"message": "The search is invalid"}]}`))
+		}))
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		_, err := client.SearchIssues("is:", git.SearchOptions{})
+		var apiErr git.ErrGitHubAPI
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+	})
+}
+
+func TestGitRetry(t *testing.T) {
+	newServer := func(t *testing.T, treeSha string, calls map[string]int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Method + " " + r.URL.Path
+			calls[key]++
+			switch key {
+			case "GET /repos/test-owner/test-repo/git/refs/heads/main":
+				w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "current-commit-sha", "type": "commit"}}`))
+			case "GET /repos/test-owner/test-repo/git/commits/current-commit-sha":
+				w.Write([]byte(`{"sha": "current-commit-sha", "tree": {"sha": "current-tree-sha"}}`))
+			case "POST /repos/test-owner/test-repo/git/blobs":
+				if calls[key] == 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"sha": "blob-sha"}`))
+			case "POST /repos/test-owner/test-repo/git/trees":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(fmt.Sprintf(`{"sha": %q}`, treeSha)))
+			case "POST /repos/test-owner/test-repo/git/commits":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"sha": "new-commit-sha", "tree": {"sha": "new-tree-sha"}}`))
+			case "PATCH /repos/test-owner/test-repo/git/refs/heads/main":
+				if calls[key] == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "new-commit-sha", "type": "commit"}}`))
+			case "POST /repos/test-owner/test-repo/pulls":
+				w.WriteHeader(http.StatusServiceUnavailable)
+			default:
+				t.Errorf("unexpected request: %s", key)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+	batch := git.BatchFileUpdate{
+		Branch:  "main",
+		Message: "Update config",
+		Files:   []git.FileOperation{{Path: "config.yaml", Content: "replicas: 2"}},
+	}
+
+	t.Run("retries 5xx", func(t *testing.T) {
+		calls := map[string]int{}
+		server := newServer(t, "new-tree-sha", calls)
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+			git.WithRetry(2, time.Millisecond),
+		)
+
+		assert.Error(t, client.CreateUpdateMultipleFiles(batch))
+		assert.Equal(t, 2, calls["POST /repos/test-owner/test-repo/git/blobs"])
+		assert.Equal(t, 1, calls["PATCH /repos/test-owner/test-repo/git/refs/heads/main"], "updating a reference is not retryable")
+
+		assert.NoError(t, client.CreateUpdateMultipleFiles(batch))
+		assert.Equal(t, 2, calls["PATCH /repos/test-owner/test-repo/git/refs/heads/main"])
+
+		_, err := client.CreatePullRequest("main", "feature", "title", "description")
+		assert.Error(t, err)
+		assert.Equal(t, 1, calls["POST /repos/test-owner/test-repo/pulls"], "creating a pull request is not retryable")
+	})
+
+	t.Run("no retries by default", func(t *testing.T) {
+		calls := map[string]int{}
+		server := newServer(t, "new-tree-sha", calls)
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+		)
+
+		assert.Error(t, client.CreateUpdateMultipleFiles(batch))
+		assert.Equal(t, 1, calls["POST /repos/test-owner/test-repo/git/blobs"])
+	})
+
+	t.Run("already committed", func(t *testing.T) {
+		calls := map[string]int{}
+		server := newServer(t, "current-tree-sha", calls)
+		defer server.Close()
+
+		client := git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(server.URL),
+			git.WithRetry(1, time.Millisecond),
+		)
+
+		assert.NoError(t, client.CreateUpdateMultipleFiles(batch))
+		assert.Zero(t, calls["POST /repos/test-owner/test-repo/git/commits"])
+		assert.Zero(t, calls["PATCH /repos/test-owner/test-repo/git/refs/heads/main"])
+	})
+}
+
+func TestGitProposeChanges(t *testing.T) {
+	newServer := func(t *testing.T, calls map[string]int, fail string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Method + " " + r.URL.Path
+			calls[key]++
+			if key == fail {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{"message": "Validation Failed"}`))
+				return
+			}
+			switch key {
+			case "GET /repos/test-owner/test-repo/git/refs/heads/feature":
+				if calls["POST /repos/test-owner/test-repo/git/refs"] == 0 {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte(`{"ref": "refs/heads/feature", "object": {"sha": "base-commit-sha", "type": "commit"}}`))
+			case "GET /repos/test-owner/test-repo/git/refs/heads/main":
+				w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "base-commit-sha", "type": "commit"}}`))
+			case "POST /repos/test-owner/test-repo/git/refs":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"ref": "refs/heads/feature", "object": {"sha": "base-commit-sha", "type": "commit"}}`))
+			case "GET /repos/test-owner/test-repo/git/commits/base-commit-sha":
+				w.Write([]byte(`{"sha": "base-commit-sha", "tree": {"sha": "base-tree-sha"}}`))
+			case "POST /repos/test-owner/test-repo/git/blobs":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"sha": "blob-sha"}`))
+			case "POST /repos/test-owner/test-repo/git/trees":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"sha": "new-tree-sha"}`))
+			case "POST /repos/test-owner/test-repo/git/commits":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"sha": "new-commit-sha", "tree": {"sha": "new-tree-sha"}}`))
+			case "PATCH /repos/test-owner/test-repo/git/refs/heads/feature":
+				w.Write([]byte(`{"ref": "refs/heads/feature", "object": {"sha": "new-commit-sha", "type": "commit"}}`))
+			case "POST /repos/test-owner/test-repo/pulls":
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"number": 42}`))
+			case "POST /repos/test-owner/test-repo/pulls/42/requested_reviewers":
+				w.WriteHeader(http.StatusCreated)
+			case "DELETE /repos/test-owner/test-repo/git/refs/heads/feature":
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Errorf("unexpected request: %s", key)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	}
+	req := git.ProposeRequest{
+		BaseBranch: "main",
+		Branch:     "feature",
+		Files:      []git.FileOperation{{Path: "config.yaml", Content: "replicas: 2"}},
+		Message:    "Update config",
+		Title:      "Update config",
+		Body:       "Scale to 2 replicas",
+		Reviewers:  git.Reviewers{Users: []string{"octocat"}},
+	}
+	newClient := func(serverURL string) git.IGit {
+		return git.New(
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(serverURL),
+		)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		calls := map[string]int{}
+		server := newServer(t, calls, "")
+		defer server.Close()
+
+		number, err := newClient(server.URL).ProposeChanges(req)
+		assert.NoError(t, err)
+		assert.Equal(t, 42, number)
+		assert.Equal(t, 1, calls["PATCH /repos/test-owner/test-repo/git/refs/heads/feature"])
+		assert.Equal(t, 1, calls["POST /repos/test-owner/test-repo/pulls/42/requested_reviewers"])
+		assert.Zero(t, calls["DELETE /repos/test-owner/test-repo/git/refs/heads/feature"])
+	})
+
+	t.Run("existing branch", func(t *testing.T) {
+		calls := map[string]int{"POST /repos/test-owner/test-repo/git/refs": 1}
+		server := newServer(t, calls, "")
+		defer server.Close()
+
+		_, err := newClient(server.URL).ProposeChanges(req)
+		var proposeErr git.ErrProposeFailed
+		assert.ErrorAs(t, err, &proposeErr)
+		assert.Equal(t, git.ProposeStepBranch, proposeErr.Step)
+		assert.Equal(t, 1, calls["POST /repos/test-owner/test-repo/git/refs"], "the branch is not created")
+	})
+
+	t.Run("branch created concurrently", func(t *testing.T) {
+		calls := map[string]int{}
+		server := newServer(t, calls, "POST /repos/test-owner/test-repo/git/refs")
+		defer server.Close()
+
+		_, err := newClient(server.URL).ProposeChanges(req)
+		var proposeErr git.ErrProposeFailed
+		assert.ErrorAs(t, err, &proposeErr)
+		assert.Equal(t, git.ProposeStepBranch, proposeErr.Step)
+		assert.IsType(t, git.ErrFailedToCreateBranch{}, proposeErr.Err)
+		assert.False(t, proposeErr.BranchDeleted)
+		assert.Zero(t, calls["POST /repos/test-owner/test-repo/git/blobs"])
+		assert.Zero(t, calls["DELETE /repos/test-owner/test-repo/git/refs/heads/feature"], "the branch of someone else is kept")
+	})
+
+	t.Run("commit fails", func(t *testing.T) {
+		calls := map[string]int{}
+		server := newServer(t, calls, "POST /repos/test-owner/test-repo/git/trees")
+		defer server.Close()
+
+		number, err := newClient(server.URL).ProposeChanges(req)
+		assert.Zero(t, number)
+		var proposeErr git.ErrProposeFailed
+		assert.ErrorAs(t, err, &proposeErr)
+		assert.Equal(t, git.ProposeStepFiles, proposeErr.Step)
+		assert.True(t, proposeErr.BranchDeleted)
+		assert.Equal(t, 1, calls["DELETE /repos/test-owner/test-repo/git/refs/heads/feature"])
+		assert.Zero(t, calls["POST /repos/test-owner/test-repo/pulls"])
+	})
+
+	t.Run("pull request fails", func(t *testing.T) {
+		calls := map[string]int{}
+		server := newServer(t, calls, "POST /repos/test-owner/test-repo/pulls")
+		defer server.Close()
+
+		_, err := newClient(server.URL).ProposeChanges(req)
+		var proposeErr git.ErrProposeFailed
+		assert.ErrorAs(t, err, &proposeErr)
+		assert.Equal(t, git.ProposeStepPullRequest, proposeErr.Step)
+		assert.True(t, proposeErr.BranchDeleted)
+	})
+
+	t.Run("reviewers fail", func(t *testing.T) {
+		calls := map[string]int{}
+		server := newServer(t, calls, "POST /repos/test-owner/test-repo/pulls/42/requested_reviewers")
+		defer server.Close()
+
+		number, err := newClient(server.URL).ProposeChanges(req)
+		assert.Equal(t, 42, number, "the pull request is kept")
+		var proposeErr git.ErrProposeFailed
+		assert.ErrorAs(t, err, &proposeErr)
+		assert.Equal(t, git.ProposeStepReviewers, proposeErr.Step)
+		assert.Zero(t, calls["DELETE /repos/test-owner/test-repo/git/refs/heads/feature"])
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		client := newClient("http://127.0.0.1:0")
+		_, err := client.ProposeChanges(git.ProposeRequest{Files: req.Files})
+		assert.IsType(t, git.ErrInvalidRef{}, err)
+		_, err = client.ProposeChanges(git.ProposeRequest{Branch: "feature"})
+		assert.IsType(t, git.ErrInvalidFiles{}, err)
+	})
+}
+
+func TestGitContext(t *testing.T) {
+	newClient := func(serverURL string, opts ...git.Option) git.IGit {
+		return git.New(append([]git.Option{
+			git.WithOwner("test-owner"),
+			git.WithRepo("test-repo"),
+			git.WithToken("test-token"),
+			git.WithBaseURL(serverURL),
+		}, opts...)...)
+	}
+
+	t.Run("per-call deadline", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/repos/test-owner/test-repo/git/refs/heads/slow" {
+				w.Write([]byte(`{"ref": "refs/heads/main", "object": {"sha": "abc123", "type": "commit"}}`))
+				return
+			}
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}))
+		defer server.Close()
+		client := newClient(server.URL)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := client.GetBranchContext(ctx, "slow")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		branch, err := client.GetBranch("main")
+		assert.NoError(t, err, "the per-call context does not apply to other calls")
+		assert.Equal(t, "abc123", branch.Object.Sha)
+	})
+
+	t.Run("client context", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		client := newClient(server.URL, git.WithContext(ctx))
+
+		_, err := client.GetBranch("main")
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, calls)
+	})
+
+	t.Run("retry wait", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		client := newClient(server.URL, git.WithRetry(3, time.Hour))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err := client.DoContext(ctx, http.MethodGet, "repos/test-owner/test-repo/labels", nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestGitListTreeFiltered(t *testing.T) {
+	response := []byte(`{
+		"sha": "tree-sha",
+		"tree": [
+			{"path": "go.mod", "mode": "100644", "type": "blob", "sha": "a"},
+			{"path": "main.go", "mode": "100644", "type": "blob", "sha": "b"},
+			{"path": "main_test.go", "mode": "100644", "type": "blob", "sha": "c"},
+			{"path": "pkg", "mode": "040000", "type": "tree", "sha": "d"},
+			{"path": "pkg/git/git.go", "mode": "100644", "type": "blob", "sha": "e"},
+			{"path": "vendor/lib/lib.go", "mode": "100644", "type": "blob", "sha": "f"},
+			{"path": "readme.md", "mode": "100644", "type": "blob", "sha": "g"}
+		],
+		"truncated": false
+	}`)
+
+	tests := []struct {
+		name      string
+		include   []string
+		exclude   []string
+		response  []byte
+		wantPaths []string
+		wantError error
+	}{
+		{
+			name:      "no filters",
+			response:  response,
+			wantPaths: []string{"go.mod", "main.go", "main_test.go", "pkg", "pkg/git/git.go", "vendor/lib/lib.go", "readme.md"},
+		},
+		{
+			name:      "include and exclude",
+			include:   []string{"**/*.go", "go.mod"},
+			exclude:   []string{"vendor/**", "**/*_test.go"},
+			response:  response,
+			wantPaths: []string{"go.mod", "main.go", "pkg/git/git.go"},
+		},
+		{
+			name:      "single segment patterns only match the top level",
+			include:   []string{"*.go"},
+			response:  response,
+			wantPaths: []string{"main.go", "main_test.go"},
+		},
+		{
+			name:      "invalid pattern",
+			include:   []string{"[a-"},
+			wantError: git.ErrInvalidPattern{Value: "[a-"},
+		},
+		{
+			name:      "truncated tree",
+			response:  []byte(`{"sha": "tree-sha", "tree": [], "truncated": true}`),
+			wantError: git.ErrTreeTruncated{Value: "main"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/repos/test-owner/test-repo/git/trees/main", r.URL.Path)
+				assert.Equal(t, "1", r.URL.Query().Get("recursive"))
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := git.New(
+				git.WithOwner("test-owner"),
+				git.WithRepo("test-repo"),
+				git.WithToken("test-token"),
+				git.WithBaseURL(server.URL),
+			)
+
+			entries, err := client.ListTreeFiltered("main", tt.include, tt.exclude)
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+				return
+			}
+			assert.NoError(t, err)
+			paths := []string{}
+			for _, entry := range entries {
+				paths = append(paths, entry.Path)
+			}
+			assert.Equal(t, tt.wantPaths, paths)
+		})
+	}
+}
+
+func TestGitDownloadArchive(t *testing.T) {
+	archive := []byte("\x1f\x8b archive bytes")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/test-owner/test-repo/tarball/main":
+			assert.Equal(t, "token test-token", r.Header.Get("Authorization"))
+			http.Redirect(w, r, "/codeload/test-owner/test-repo/legacy.tar.gz/refs/heads/main", http.StatusFound)
+		case "/codeload/test-owner/test-repo/legacy.tar.gz/refs/heads/main":
+			w.Header().Set("Content-Type", "application/x-gzip")
+			w.Write(archive)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "Not Found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := git.New(
+		git.WithOwner("test-owner"),
+		git.WithRepo("test-repo"),
+		git.WithToken("test-token"),
+		git.WithBaseURL(server.URL),
+	)
+
+	t.Run("follows the redirect", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := client.DownloadArchive("main", git.ArchiveFormatTarball, &buf)
+		assert.NoError(t, err)
+		assert.Equal(t, archive, buf.Bytes())
+	})
+
+	t.Run("unknown ref", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := client.DownloadArchive("missing", git.ArchiveFormatZipball, &buf)
+		var apiErr git.ErrGitHubAPI
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		err := client.DownloadArchive("main", "tar.gz", io.Discard)
+		assert.IsType(t, git.ErrInvalidArchiveFormat{}, err)
+	})
+
+	t.Run("empty ref", func(t *testing.T) {
+		err := client.DownloadArchive("", git.ArchiveFormatTarball, io.Discard)
+		assert.IsType(t, git.ErrInvalidRef{}, err)
+	})
+}
@@ -0,0 +1,66 @@
+package git
+
+import (
+	"path"
+	"strings"
+)
+
+// matchGlob reports whether name matches pattern. Patterns are matched against the whole
+// slash separated path: each segment follows path.Match and a "**" segment matches zero or
+// more segments, e.g. "docs/**/*.md" matches "docs/a.md" and "docs/a/b/c.md".
+func matchGlob(pattern string, name string) (bool, error) {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern []string, name []string) (bool, error) {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// collapse consecutive "**" and try every possible number of skipped segments
+			for len(pattern) > 0 && pattern[0] == "**" {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true, nil
+			}
+			for i := range name {
+				ok, err := matchSegments(pattern, name[i:])
+				if ok || err != nil {
+					return ok, err
+				}
+			}
+			return false, nil
+		}
+		if len(name) == 0 {
+			return false, nil
+		}
+		ok, err := path.Match(pattern[0], name[0])
+		if !ok || err != nil {
+			return false, err
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0, nil
+}
+
+// matchAny reports whether name matches one of patterns.
+func matchAny(patterns []string, name string) (bool, error) {
+	for _, pattern := range patterns {
+		ok, err := matchGlob(pattern, name)
+		if ok || err != nil {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// validateGlobs returns ErrInvalidPattern if one of patterns is malformed.
+func validateGlobs(patterns []string) error {
+	for _, pattern := range patterns {
+		for _, segment := range strings.Split(pattern, "/") {
+			if _, err := path.Match(segment, ""); err != nil {
+				return ErrInvalidPattern{Value: pattern}
+			}
+		}
+	}
+	return nil
+}
@@ -1,11 +1,57 @@
 package git
 
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
 type IGit interface {
+	GetRepository() (*Repository, error)
+	GetDefaultBranch() (string, error)
 	GetBranch(branch string) (*BranchInfo, error)
 	CreateBranch(branch string, sha string) (*BranchInfo, error)
+	CreateBranchFromBranch(newBranch string, fromBranch string) (*BranchInfo, error)
+	EnsureBranch(branch string, baseBranch string) (*BranchInfo, error)
+	GetBranchProtection(branch string) (*BranchProtection, error)
+	UpdateBranchProtection(branch string, rules BranchProtection) error
 	GetAFile(branch string, filePath string) (*FileInfo, error)
+	GetContents(branch string, path string) (*Contents, error)
+	ListTreeFiltered(sha string, include []string, exclude []string) ([]TreeEntry, error)
+	DownloadArchive(ref string, format string, w io.Writer) error
 	CreateUpdateAFile(branch string, filePath string, content []byte, message string, sha string) (*FileResponse, error)
 	CreatePullRequest(baseBranch string, branch string, title string, description string) (int, error)
+	UpdatePullRequestBase(number int, newBase string) error
 	AddReviewers(number int, prReviewers Reviewers) error
+	AddAssignees(number int, assignees []string) error
+	RemoveAssignees(number int, assignees []string) error
+	SubmitReview(number int, event string, body string) error
+	ListReviews(number int) ([]Review, error)
 	CreateUpdateMultipleFiles(batch BatchFileUpdate) error
+	CreateBlob(content []byte, encoding string) (*BlobResponse, error)
+	GetBlob(sha string) ([]byte, error)
+	CreateTree(baseTreeSha string, entries []TreeEntry) (*TreeResponse, error)
+	CreateCommit(message string, treeSha string, parents []string) (*CommitResponse, error)
+	GetCommitVerification(sha string) (*Verification, error)
+	ListCommits(opts CommitListOptions) ([]CommitResponse, error)
+	CreateRepositoryDispatch(eventType string, payload map[string]interface{}) error
+	TriggerWorkflow(workflowFileOrID string, ref string, inputs map[string]string) error
+	ListWorkflowRuns(workflowFileOrID string, opts WorkflowRunOptions) ([]WorkflowRun, error)
+	CreateStatus(sha string, state string, targetURL string, description string, context string) error
+	SearchIssues(query string, opts SearchOptions) (*SearchResult, error)
+	GetRateLimit() (*RateLimit, error)
+	Do(method string, path string, body interface{}) (*http.Response, error)
+	ProposeChanges(req ProposeRequest) (int, error)
+
+	GetBranchContext(ctx context.Context, branch string) (*BranchInfo, error)
+	EnsureBranchContext(ctx context.Context, branch string, baseBranch string) (*BranchInfo, error)
+	GetAFileContext(ctx context.Context, branch string, filePath string) (*FileInfo, error)
+	GetContentsContext(ctx context.Context, branch string, path string) (*Contents, error)
+	CreateUpdateAFileContext(ctx context.Context, branch string, filePath string, content []byte, message string, sha string) (*FileResponse, error)
+	CreateUpdateMultipleFilesContext(ctx context.Context, batch BatchFileUpdate) error
+	CreatePullRequestContext(ctx context.Context, baseBranch string, branch string, title string, description string) (int, error)
+	ListWorkflowRunsContext(ctx context.Context, workflowFileOrID string, opts WorkflowRunOptions) ([]WorkflowRun, error)
+	SearchIssuesContext(ctx context.Context, query string, opts SearchOptions) (*SearchResult, error)
+	ProposeChangesContext(ctx context.Context, req ProposeRequest) (int, error)
+	DoContext(ctx context.Context, method string, path string, body interface{}) (*http.Response, error)
 }
@@ -0,0 +1,21 @@
+package git
+
+import "time"
+
+// Logger is the interface used to emit structured logs for every GitHub API call.
+// keysAndValues are alternating key/value pairs, e.g. "method", "GET".
+type Logger interface {
+	Debug(msg string, keysAndValues ...any)
+	Error(msg string, keysAndValues ...any)
+}
+
+// MetricsFunc is invoked after every GitHub API call with the HTTP method,
+// the response status code (0 if no response was received) and the call duration.
+type MetricsFunc func(method string, status int, dur time.Duration)
+
+// noopLogger discards all log entries.
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...any) {}
+
+func (noopLogger) Error(string, ...any) {}
@@ -10,6 +10,9 @@
 package mocks
 
 import (
+	context "context"
+	io "io"
+	http "net/http"
 	reflect "reflect"
 
 	git "github.com/pal-paul/go-libraries/pkg/git"
@@ -40,6 +43,20 @@ func (m *MockIGit) EXPECT() *MockIGitMockRecorder {
 	return m.recorder
 }
 
+// AddAssignees mocks base method.
+func (m *MockIGit) AddAssignees(number int, assignees []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAssignees", number, assignees)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAssignees indicates an expected call of AddAssignees.
+func (mr *MockIGitMockRecorder) AddAssignees(number, assignees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAssignees", reflect.TypeOf((*MockIGit)(nil).AddAssignees), number, assignees)
+}
+
 // AddReviewers mocks base method.
 func (m *MockIGit) AddReviewers(number int, prReviewers git.Reviewers) error {
 	m.ctrl.T.Helper()
@@ -54,6 +71,21 @@ func (mr *MockIGitMockRecorder) AddReviewers(number, prReviewers any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewers", reflect.TypeOf((*MockIGit)(nil).AddReviewers), number, prReviewers)
 }
 
+// CreateBlob mocks base method.
+func (m *MockIGit) CreateBlob(content []byte, encoding string) (*git.BlobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlob", content, encoding)
+	ret0, _ := ret[0].(*git.BlobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBlob indicates an expected call of CreateBlob.
+func (mr *MockIGitMockRecorder) CreateBlob(content, encoding any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlob", reflect.TypeOf((*MockIGit)(nil).CreateBlob), content, encoding)
+}
+
 // CreateBranch mocks base method.
 func (m *MockIGit) CreateBranch(branch, sha string) (*git.BranchInfo, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +101,36 @@ func (mr *MockIGitMockRecorder) CreateBranch(branch, sha any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBranch", reflect.TypeOf((*MockIGit)(nil).CreateBranch), branch, sha)
 }
 
+// CreateBranchFromBranch mocks base method.
+func (m *MockIGit) CreateBranchFromBranch(newBranch, fromBranch string) (*git.BranchInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBranchFromBranch", newBranch, fromBranch)
+	ret0, _ := ret[0].(*git.BranchInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBranchFromBranch indicates an expected call of CreateBranchFromBranch.
+func (mr *MockIGitMockRecorder) CreateBranchFromBranch(newBranch, fromBranch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBranchFromBranch", reflect.TypeOf((*MockIGit)(nil).CreateBranchFromBranch), newBranch, fromBranch)
+}
+
+// CreateCommit mocks base method.
+func (m *MockIGit) CreateCommit(message, treeSha string, parents []string) (*git.CommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCommit", message, treeSha, parents)
+	ret0, _ := ret[0].(*git.CommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCommit indicates an expected call of CreateCommit.
+func (mr *MockIGitMockRecorder) CreateCommit(message, treeSha, parents any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCommit", reflect.TypeOf((*MockIGit)(nil).CreateCommit), message, treeSha, parents)
+}
+
 // CreatePullRequest mocks base method.
 func (m *MockIGit) CreatePullRequest(baseBranch, branch, title, description string) (int, error) {
 	m.ctrl.T.Helper()
@@ -84,6 +146,64 @@ func (mr *MockIGitMockRecorder) CreatePullRequest(baseBranch, branch, title, des
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequest", reflect.TypeOf((*MockIGit)(nil).CreatePullRequest), baseBranch, branch, title, description)
 }
 
+// CreatePullRequestContext mocks base method.
+func (m *MockIGit) CreatePullRequestContext(ctx context.Context, baseBranch, branch, title, description string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullRequestContext", ctx, baseBranch, branch, title, description)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePullRequestContext indicates an expected call of CreatePullRequestContext.
+func (mr *MockIGitMockRecorder) CreatePullRequestContext(ctx, baseBranch, branch, title, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequestContext", reflect.TypeOf((*MockIGit)(nil).CreatePullRequestContext), ctx, baseBranch, branch, title, description)
+}
+
+// CreateRepositoryDispatch mocks base method.
+func (m *MockIGit) CreateRepositoryDispatch(eventType string, payload map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRepositoryDispatch", eventType, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRepositoryDispatch indicates an expected call of CreateRepositoryDispatch.
+func (mr *MockIGitMockRecorder) CreateRepositoryDispatch(eventType, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRepositoryDispatch", reflect.TypeOf((*MockIGit)(nil).CreateRepositoryDispatch), eventType, payload)
+}
+
+// CreateStatus mocks base method.
+func (m *MockIGit) CreateStatus(sha, state, targetURL, description, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStatus", sha, state, targetURL, description, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStatus indicates an expected call of CreateStatus.
+func (mr *MockIGitMockRecorder) CreateStatus(sha, state, targetURL, description, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStatus", reflect.TypeOf((*MockIGit)(nil).CreateStatus), sha, state, targetURL, description, arg4)
+}
+
+// CreateTree mocks base method.
+func (m *MockIGit) CreateTree(baseTreeSha string, entries []git.TreeEntry) (*git.TreeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTree", baseTreeSha, entries)
+	ret0, _ := ret[0].(*git.TreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTree indicates an expected call of CreateTree.
+func (mr *MockIGitMockRecorder) CreateTree(baseTreeSha, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTree", reflect.TypeOf((*MockIGit)(nil).CreateTree), baseTreeSha, entries)
+}
+
 // CreateUpdateAFile mocks base method.
 func (m *MockIGit) CreateUpdateAFile(branch, filePath string, content []byte, message, sha string) (*git.FileResponse, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +219,21 @@ func (mr *MockIGitMockRecorder) CreateUpdateAFile(branch, filePath, content, mes
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpdateAFile", reflect.TypeOf((*MockIGit)(nil).CreateUpdateAFile), branch, filePath, content, message, sha)
 }
 
+// CreateUpdateAFileContext mocks base method.
+func (m *MockIGit) CreateUpdateAFileContext(ctx context.Context, branch, filePath string, content []byte, message, sha string) (*git.FileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpdateAFileContext", ctx, branch, filePath, content, message, sha)
+	ret0, _ := ret[0].(*git.FileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUpdateAFileContext indicates an expected call of CreateUpdateAFileContext.
+func (mr *MockIGitMockRecorder) CreateUpdateAFileContext(ctx, branch, filePath, content, message, sha any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpdateAFileContext", reflect.TypeOf((*MockIGit)(nil).CreateUpdateAFileContext), ctx, branch, filePath, content, message, sha)
+}
+
 // CreateUpdateMultipleFiles mocks base method.
 func (m *MockIGit) CreateUpdateMultipleFiles(batch git.BatchFileUpdate) error {
 	m.ctrl.T.Helper()
@@ -113,6 +248,94 @@ func (mr *MockIGitMockRecorder) CreateUpdateMultipleFiles(batch any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpdateMultipleFiles", reflect.TypeOf((*MockIGit)(nil).CreateUpdateMultipleFiles), batch)
 }
 
+// CreateUpdateMultipleFilesContext mocks base method.
+func (m *MockIGit) CreateUpdateMultipleFilesContext(ctx context.Context, batch git.BatchFileUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpdateMultipleFilesContext", ctx, batch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUpdateMultipleFilesContext indicates an expected call of CreateUpdateMultipleFilesContext.
+func (mr *MockIGitMockRecorder) CreateUpdateMultipleFilesContext(ctx, batch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpdateMultipleFilesContext", reflect.TypeOf((*MockIGit)(nil).CreateUpdateMultipleFilesContext), ctx, batch)
+}
+
+// Do mocks base method.
+func (m *MockIGit) Do(method, path string, body any) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", method, path, body)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIGitMockRecorder) Do(method, path, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIGit)(nil).Do), method, path, body)
+}
+
+// DoContext mocks base method.
+func (m *MockIGit) DoContext(ctx context.Context, method, path string, body any) (*http.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoContext", ctx, method, path, body)
+	ret0, _ := ret[0].(*http.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DoContext indicates an expected call of DoContext.
+func (mr *MockIGitMockRecorder) DoContext(ctx, method, path, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoContext", reflect.TypeOf((*MockIGit)(nil).DoContext), ctx, method, path, body)
+}
+
+// DownloadArchive mocks base method.
+func (m *MockIGit) DownloadArchive(ref, format string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadArchive", ref, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadArchive indicates an expected call of DownloadArchive.
+func (mr *MockIGitMockRecorder) DownloadArchive(ref, format, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadArchive", reflect.TypeOf((*MockIGit)(nil).DownloadArchive), ref, format, w)
+}
+
+// EnsureBranch mocks base method.
+func (m *MockIGit) EnsureBranch(branch, baseBranch string) (*git.BranchInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureBranch", branch, baseBranch)
+	ret0, _ := ret[0].(*git.BranchInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureBranch indicates an expected call of EnsureBranch.
+func (mr *MockIGitMockRecorder) EnsureBranch(branch, baseBranch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureBranch", reflect.TypeOf((*MockIGit)(nil).EnsureBranch), branch, baseBranch)
+}
+
+// EnsureBranchContext mocks base method.
+func (m *MockIGit) EnsureBranchContext(ctx context.Context, branch, baseBranch string) (*git.BranchInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureBranchContext", ctx, branch, baseBranch)
+	ret0, _ := ret[0].(*git.BranchInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureBranchContext indicates an expected call of EnsureBranchContext.
+func (mr *MockIGitMockRecorder) EnsureBranchContext(ctx, branch, baseBranch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureBranchContext", reflect.TypeOf((*MockIGit)(nil).EnsureBranchContext), ctx, branch, baseBranch)
+}
+
 // GetAFile mocks base method.
 func (m *MockIGit) GetAFile(branch, filePath string) (*git.FileInfo, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +351,36 @@ func (mr *MockIGitMockRecorder) GetAFile(branch, filePath any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAFile", reflect.TypeOf((*MockIGit)(nil).GetAFile), branch, filePath)
 }
 
+// GetAFileContext mocks base method.
+func (m *MockIGit) GetAFileContext(ctx context.Context, branch, filePath string) (*git.FileInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAFileContext", ctx, branch, filePath)
+	ret0, _ := ret[0].(*git.FileInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAFileContext indicates an expected call of GetAFileContext.
+func (mr *MockIGitMockRecorder) GetAFileContext(ctx, branch, filePath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAFileContext", reflect.TypeOf((*MockIGit)(nil).GetAFileContext), ctx, branch, filePath)
+}
+
+// GetBlob mocks base method.
+func (m *MockIGit) GetBlob(sha string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlob", sha)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlob indicates an expected call of GetBlob.
+func (mr *MockIGitMockRecorder) GetBlob(sha any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlob", reflect.TypeOf((*MockIGit)(nil).GetBlob), sha)
+}
+
 // GetBranch mocks base method.
 func (m *MockIGit) GetBranch(branch string) (*git.BranchInfo, error) {
 	m.ctrl.T.Helper()
@@ -142,3 +395,328 @@ func (mr *MockIGitMockRecorder) GetBranch(branch any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranch", reflect.TypeOf((*MockIGit)(nil).GetBranch), branch)
 }
+
+// GetBranchContext mocks base method.
+func (m *MockIGit) GetBranchContext(ctx context.Context, branch string) (*git.BranchInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBranchContext", ctx, branch)
+	ret0, _ := ret[0].(*git.BranchInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBranchContext indicates an expected call of GetBranchContext.
+func (mr *MockIGitMockRecorder) GetBranchContext(ctx, branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranchContext", reflect.TypeOf((*MockIGit)(nil).GetBranchContext), ctx, branch)
+}
+
+// GetBranchProtection mocks base method.
+func (m *MockIGit) GetBranchProtection(branch string) (*git.BranchProtection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBranchProtection", branch)
+	ret0, _ := ret[0].(*git.BranchProtection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBranchProtection indicates an expected call of GetBranchProtection.
+func (mr *MockIGitMockRecorder) GetBranchProtection(branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranchProtection", reflect.TypeOf((*MockIGit)(nil).GetBranchProtection), branch)
+}
+
+// GetCommitVerification mocks base method.
+func (m *MockIGit) GetCommitVerification(sha string) (*git.Verification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitVerification", sha)
+	ret0, _ := ret[0].(*git.Verification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitVerification indicates an expected call of GetCommitVerification.
+func (mr *MockIGitMockRecorder) GetCommitVerification(sha any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitVerification", reflect.TypeOf((*MockIGit)(nil).GetCommitVerification), sha)
+}
+
+// GetContents mocks base method.
+func (m *MockIGit) GetContents(branch, path string) (*git.Contents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContents", branch, path)
+	ret0, _ := ret[0].(*git.Contents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContents indicates an expected call of GetContents.
+func (mr *MockIGitMockRecorder) GetContents(branch, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContents", reflect.TypeOf((*MockIGit)(nil).GetContents), branch, path)
+}
+
+// GetContentsContext mocks base method.
+func (m *MockIGit) GetContentsContext(ctx context.Context, branch, path string) (*git.Contents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContentsContext", ctx, branch, path)
+	ret0, _ := ret[0].(*git.Contents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContentsContext indicates an expected call of GetContentsContext.
+func (mr *MockIGitMockRecorder) GetContentsContext(ctx, branch, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentsContext", reflect.TypeOf((*MockIGit)(nil).GetContentsContext), ctx, branch, path)
+}
+
+// GetDefaultBranch mocks base method.
+func (m *MockIGit) GetDefaultBranch() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultBranch")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultBranch indicates an expected call of GetDefaultBranch.
+func (mr *MockIGitMockRecorder) GetDefaultBranch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultBranch", reflect.TypeOf((*MockIGit)(nil).GetDefaultBranch))
+}
+
+// GetRateLimit mocks base method.
+func (m *MockIGit) GetRateLimit() (*git.RateLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimit")
+	ret0, _ := ret[0].(*git.RateLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimit indicates an expected call of GetRateLimit.
+func (mr *MockIGitMockRecorder) GetRateLimit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimit", reflect.TypeOf((*MockIGit)(nil).GetRateLimit))
+}
+
+// GetRepository mocks base method.
+func (m *MockIGit) GetRepository() (*git.Repository, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepository")
+	ret0, _ := ret[0].(*git.Repository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepository indicates an expected call of GetRepository.
+func (mr *MockIGitMockRecorder) GetRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepository", reflect.TypeOf((*MockIGit)(nil).GetRepository))
+}
+
+// ListCommits mocks base method.
+func (m *MockIGit) ListCommits(opts git.CommitListOptions) ([]git.CommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommits", opts)
+	ret0, _ := ret[0].([]git.CommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommits indicates an expected call of ListCommits.
+func (mr *MockIGitMockRecorder) ListCommits(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommits", reflect.TypeOf((*MockIGit)(nil).ListCommits), opts)
+}
+
+// ListReviews mocks base method.
+func (m *MockIGit) ListReviews(number int) ([]git.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReviews", number)
+	ret0, _ := ret[0].([]git.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReviews indicates an expected call of ListReviews.
+func (mr *MockIGitMockRecorder) ListReviews(number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviews", reflect.TypeOf((*MockIGit)(nil).ListReviews), number)
+}
+
+// ListTreeFiltered mocks base method.
+func (m *MockIGit) ListTreeFiltered(sha string, include, exclude []string) ([]git.TreeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTreeFiltered", sha, include, exclude)
+	ret0, _ := ret[0].([]git.TreeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTreeFiltered indicates an expected call of ListTreeFiltered.
+func (mr *MockIGitMockRecorder) ListTreeFiltered(sha, include, exclude any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTreeFiltered", reflect.TypeOf((*MockIGit)(nil).ListTreeFiltered), sha, include, exclude)
+}
+
+// ListWorkflowRuns mocks base method.
+func (m *MockIGit) ListWorkflowRuns(workflowFileOrID string, opts git.WorkflowRunOptions) ([]git.WorkflowRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowRuns", workflowFileOrID, opts)
+	ret0, _ := ret[0].([]git.WorkflowRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowRuns indicates an expected call of ListWorkflowRuns.
+func (mr *MockIGitMockRecorder) ListWorkflowRuns(workflowFileOrID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowRuns", reflect.TypeOf((*MockIGit)(nil).ListWorkflowRuns), workflowFileOrID, opts)
+}
+
+// ListWorkflowRunsContext mocks base method.
+func (m *MockIGit) ListWorkflowRunsContext(ctx context.Context, workflowFileOrID string, opts git.WorkflowRunOptions) ([]git.WorkflowRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflowRunsContext", ctx, workflowFileOrID, opts)
+	ret0, _ := ret[0].([]git.WorkflowRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflowRunsContext indicates an expected call of ListWorkflowRunsContext.
+func (mr *MockIGitMockRecorder) ListWorkflowRunsContext(ctx, workflowFileOrID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowRunsContext", reflect.TypeOf((*MockIGit)(nil).ListWorkflowRunsContext), ctx, workflowFileOrID, opts)
+}
+
+// ProposeChanges mocks base method.
+func (m *MockIGit) ProposeChanges(req git.ProposeRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposeChanges", req)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeChanges indicates an expected call of ProposeChanges.
+func (mr *MockIGitMockRecorder) ProposeChanges(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeChanges", reflect.TypeOf((*MockIGit)(nil).ProposeChanges), req)
+}
+
+// ProposeChangesContext mocks base method.
+func (m *MockIGit) ProposeChangesContext(ctx context.Context, req git.ProposeRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposeChangesContext", ctx, req)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeChangesContext indicates an expected call of ProposeChangesContext.
+func (mr *MockIGitMockRecorder) ProposeChangesContext(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeChangesContext", reflect.TypeOf((*MockIGit)(nil).ProposeChangesContext), ctx, req)
+}
+
+// RemoveAssignees mocks base method.
+func (m *MockIGit) RemoveAssignees(number int, assignees []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAssignees", number, assignees)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveAssignees indicates an expected call of RemoveAssignees.
+func (mr *MockIGitMockRecorder) RemoveAssignees(number, assignees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAssignees", reflect.TypeOf((*MockIGit)(nil).RemoveAssignees), number, assignees)
+}
+
+// SearchIssues mocks base method.
+func (m *MockIGit) SearchIssues(query string, opts git.SearchOptions) (*git.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchIssues", query, opts)
+	ret0, _ := ret[0].(*git.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchIssues indicates an expected call of SearchIssues.
+func (mr *MockIGitMockRecorder) SearchIssues(query, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIssues", reflect.TypeOf((*MockIGit)(nil).SearchIssues), query, opts)
+}
+
+// SearchIssuesContext mocks base method.
+func (m *MockIGit) SearchIssuesContext(ctx context.Context, query string, opts git.SearchOptions) (*git.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchIssuesContext", ctx, query, opts)
+	ret0, _ := ret[0].(*git.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchIssuesContext indicates an expected call of SearchIssuesContext.
+func (mr *MockIGitMockRecorder) SearchIssuesContext(ctx, query, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIssuesContext", reflect.TypeOf((*MockIGit)(nil).SearchIssuesContext), ctx, query, opts)
+}
+
+// SubmitReview mocks base method.
+func (m *MockIGit) SubmitReview(number int, event, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitReview", number, event, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitReview indicates an expected call of SubmitReview.
+func (mr *MockIGitMockRecorder) SubmitReview(number, event, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReview", reflect.TypeOf((*MockIGit)(nil).SubmitReview), number, event, body)
+}
+
+// TriggerWorkflow mocks base method.
+func (m *MockIGit) TriggerWorkflow(workflowFileOrID, ref string, inputs map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerWorkflow", workflowFileOrID, ref, inputs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TriggerWorkflow indicates an expected call of TriggerWorkflow.
+func (mr *MockIGitMockRecorder) TriggerWorkflow(workflowFileOrID, ref, inputs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerWorkflow", reflect.TypeOf((*MockIGit)(nil).TriggerWorkflow), workflowFileOrID, ref, inputs)
+}
+
+// UpdateBranchProtection mocks base method.
+func (m *MockIGit) UpdateBranchProtection(branch string, rules git.BranchProtection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBranchProtection", branch, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBranchProtection indicates an expected call of UpdateBranchProtection.
+func (mr *MockIGitMockRecorder) UpdateBranchProtection(branch, rules any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBranchProtection", reflect.TypeOf((*MockIGit)(nil).UpdateBranchProtection), branch, rules)
+}
+
+// UpdatePullRequestBase mocks base method.
+func (m *MockIGit) UpdatePullRequestBase(number int, newBase string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePullRequestBase", number, newBase)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePullRequestBase indicates an expected call of UpdatePullRequestBase.
+func (mr *MockIGitMockRecorder) UpdatePullRequestBase(number, newBase any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestBase", reflect.TypeOf((*MockIGit)(nil).UpdatePullRequestBase), number, newBase)
+}
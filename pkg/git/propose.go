@@ -0,0 +1,142 @@
+package git
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Steps of ProposeChanges, reported by ErrProposeFailed.
+const (
+	ProposeStepBranch      = "create branch"
+	ProposeStepFiles       = "commit files"
+	ProposeStepPullRequest = "create pull request"
+	ProposeStepReviewers   = "add reviewers"
+)
+
+// ProposeRequest describes the changes proposed by ProposeChanges.
+type ProposeRequest struct {
+	BaseBranch string          // BaseBranch is the branch to branch off and merge into, empty for the default branch
+	Branch     string          // Branch is the new branch holding the changes
+	Files      []FileOperation // Files are the files created or updated by the commit
+	Message    string          // Message is the commit message
+	Title      string          // Title is the pull request title
+	Body       string          // Body is the pull request description
+	Reviewers  Reviewers       // Reviewers are requested on the pull request, may be empty
+}
+
+// ProposeChanges opens a pull request with changes to files: it creates the branch from the head of
+// the base branch, commits the files to it, opens the pull request and requests the reviewers.
+// When committing the files or opening the pull request fails, the branch is deleted again so that
+// no half-done branch is left behind. When requesting the reviewers fails, the pull request is kept
+// and its number is returned along with the error.
+// Parameters:
+//   - req: The base and new branch, the files and commit message, the pull request title and
+//     description and the reviewers.
+//
+// Returns:
+//   - The number of the pull request.
+//   - ErrInvalidRef if the branch is empty, or ErrInvalidFiles if there are no files.
+//   - ErrProposeFailed with the step that failed and its error. Creating the branch fails when
+//     it already exists, also when it was created concurrently, so that existing work is never
+//     modified or deleted.
+func (g *git) ProposeChanges(req ProposeRequest) (int, error) {
+	if req.Branch == "" {
+		return 0, ErrInvalidRef{Value: "branch is empty"}
+	}
+	if len(req.Files) == 0 {
+		return 0, ErrInvalidFiles{Value: "no files to propose"}
+	}
+
+	if err := g.createProposalBranch(req.Branch, req.BaseBranch); err != nil {
+		return 0, ErrProposeFailed{Step: ProposeStepBranch, Err: err}
+	}
+
+	err := g.CreateUpdateMultipleFiles(BatchFileUpdate{
+		Branch:  req.Branch,
+		Message: req.Message,
+		Files:   req.Files,
+	})
+	if err != nil {
+		return 0, g.rollbackProposal(req.Branch, ProposeStepFiles, err)
+	}
+
+	number, err := g.CreatePullRequest(req.BaseBranch, req.Branch, req.Title, req.Body)
+	if err != nil {
+		return 0, g.rollbackProposal(req.Branch, ProposeStepPullRequest, err)
+	}
+
+	if len(req.Reviewers.Users) > 0 || len(req.Reviewers.Teams) > 0 {
+		if err := g.AddReviewers(number, req.Reviewers); err != nil {
+			return number, ErrProposeFailed{Step: ProposeStepReviewers, Err: err}
+		}
+	}
+	return number, nil
+}
+
+// createProposalBranch creates branch from the head of baseBranch, or of the default branch when
+// baseBranch is empty. Unlike EnsureBranch it fails when the branch already exists, including when
+// it is created concurrently between the check and the create, so that the rollback of
+// ProposeChanges only ever deletes a branch it created.
+func (g *git) createProposalBranch(branch string, baseBranch string) error {
+	existing, err := g.GetBranch(branch)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return ErrFailedToCreateBranch{Value: fmt.Sprintf("%s already exists", branch)}
+	}
+	if baseBranch == "" {
+		baseBranch, err = g.GetDefaultBranch()
+		if err != nil {
+			return err
+		}
+	}
+	baseInfo, err := g.GetBranch(baseBranch)
+	if err != nil {
+		return err
+	}
+	if baseInfo == nil {
+		return ErrBranchNotFound{Value: baseBranch}
+	}
+	resp, err := g.createRef(branch, baseInfo.Object.Sha)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	case 201:
+		return nil
+	case 422:
+		return ErrFailedToCreateBranch{Value: fmt.Sprintf("%s: %v", branch, apiError(resp))}
+	default:
+		return ErrFailedToCreateBranch{Value: fmt.Sprintf("%s: %s", branch, resp.Status)}
+	}
+}
+
+// rollbackProposal deletes the branch created by ProposeChanges after step failed with err.
+func (g *git) rollbackProposal(branch string, step string, err error) error {
+	proposeErr := ErrProposeFailed{Step: step, Err: err}
+	if deleteErr := g.deleteBranch(branch); deleteErr != nil {
+		g.cfg.Logger.Error("failed to delete proposal branch", "branch", branch, "error", deleteErr)
+		return proposeErr
+	}
+	proposeErr.BranchDeleted = true
+	return proposeErr
+}
+
+// deleteBranch deletes the reference of branch.
+func (g *git) deleteBranch(branch string) error {
+	resp, err := g.do(
+		http.MethodDelete,
+		"repos",
+		fmt.Sprintf("%s/%s/git/refs/heads/%s", g.cfg.Owner, g.cfg.Repo, branch),
+		nil,
+		nil,
+	)
+	if err != nil {
+		return ErrFailedToDeleteBranch{Value: fmt.Sprintf("%s: %v", branch, err)}
+	}
+	if resp.StatusCode != 204 {
+		return ErrFailedToDeleteBranch{Value: fmt.Sprintf("%s: %s", branch, resp.Status)}
+	}
+	return nil
+}
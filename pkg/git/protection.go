@@ -0,0 +1,217 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// BranchProtection is the protection of a branch. A nil rule is disabled.
+type BranchProtection struct {
+	RequiredStatusChecks *RequiredStatusChecks // RequiredStatusChecks must pass before merging
+	RequiredReviews      *RequiredReviews      // RequiredReviews must approve pull requests before merging
+	EnforceAdmins        bool                  // EnforceAdmins applies the rules to administrators too
+	Restrictions         *BranchRestrictions   // Restrictions limit who can push, only for organization repositories
+}
+
+// RequiredStatusChecks are the status checks that must pass before merging into a branch.
+type RequiredStatusChecks struct {
+	Strict   bool     // Strict requires branches to be up to date with the base branch before merging
+	Contexts []string // Contexts are the names of the required checks, e.g. ci/build
+}
+
+// RequiredReviews are the reviews pull requests need before merging into a branch.
+type RequiredReviews struct {
+	RequiredApprovingReviewCount int  // RequiredApprovingReviewCount is the number of approvals, from 0 to 6
+	DismissStaleReviews          bool // DismissStaleReviews dismisses approvals when new commits are pushed
+	RequireCodeOwnerReviews      bool // RequireCodeOwnerReviews requires an approval from a code owner
+}
+
+// BranchRestrictions are the users, teams and apps allowed to push to a branch. Empty lists
+// allow nobody but administrators.
+type BranchRestrictions struct {
+	Users []string // Users are user logins
+	Teams []string // Teams are team slugs
+	Apps  []string // Apps are app slugs
+}
+
+// branchProtectionRequest is the body of an update of a branch protection. GitHub requires every
+// rule to be set, to null when it is disabled.
+type branchProtectionRequest struct {
+	RequiredStatusChecks *requiredStatusChecksRequest `json:"required_status_checks"`
+	EnforceAdmins        bool                         `json:"enforce_admins"`
+	RequiredReviews      *requiredReviewsRequest      `json:"required_pull_request_reviews"`
+	Restrictions         *branchRestrictionsRequest   `json:"restrictions"`
+}
+
+type requiredStatusChecksRequest struct {
+	Strict   bool     `json:"strict"`
+	Contexts []string `json:"contexts"`
+}
+
+type requiredReviewsRequest struct {
+	DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+	RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+	RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+}
+
+type branchRestrictionsRequest struct {
+	Users []string `json:"users"`
+	Teams []string `json:"teams"`
+	Apps  []string `json:"apps"`
+}
+
+// branchProtectionResponse is a branch protection as returned by GitHub, which wraps flags in
+// objects and lists users, teams and apps as objects.
+type branchProtectionResponse struct {
+	RequiredStatusChecks *struct {
+		Strict   bool     `json:"strict"`
+		Contexts []string `json:"contexts"`
+	} `json:"required_status_checks"`
+	EnforceAdmins *struct {
+		Enabled bool `json:"enabled"`
+	} `json:"enforce_admins"`
+	RequiredReviews *struct {
+		DismissStaleReviews          bool `json:"dismiss_stale_reviews"`
+		RequireCodeOwnerReviews      bool `json:"require_code_owner_reviews"`
+		RequiredApprovingReviewCount int  `json:"required_approving_review_count"`
+	} `json:"required_pull_request_reviews"`
+	Restrictions *struct {
+		Users []struct {
+			Login string `json:"login"`
+		} `json:"users"`
+		Teams []struct {
+			Slug string `json:"slug"`
+		} `json:"teams"`
+		Apps []struct {
+			Slug string `json:"slug"`
+		} `json:"apps"`
+	} `json:"restrictions"`
+}
+
+// GetBranchProtection retrieves the protection of a branch.
+// Parameters:
+//   - branch: The name of the branch.
+//
+// Returns:
+//   - A pointer to a BranchProtection struct, or nil if the branch is not protected.
+//   - ErrInvalidRef if the branch is empty.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK, e.g. when the
+//     branch does not exist.
+func (g *git) GetBranchProtection(branch string) (*BranchProtection, error) {
+	if branch == "" {
+		return nil, ErrInvalidRef{Value: "branch is empty"}
+	}
+	resp, err := g.get("repos", g.branchProtectionPath(branch), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		err := apiError(resp)
+		if apiErr, ok := err.(ErrGitHubAPI); ok && apiErr.StatusCode == 404 && apiErr.Message == "Branch not protected" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var protectionResp branchProtectionResponse
+	if err := json.Unmarshal(body, &protectionResp); err != nil {
+		return nil, err
+	}
+
+	protection := &BranchProtection{}
+	if checks := protectionResp.RequiredStatusChecks; checks != nil {
+		protection.RequiredStatusChecks = &RequiredStatusChecks{
+			Strict:   checks.Strict,
+			Contexts: checks.Contexts,
+		}
+	}
+	if reviews := protectionResp.RequiredReviews; reviews != nil {
+		protection.RequiredReviews = &RequiredReviews{
+			RequiredApprovingReviewCount: reviews.RequiredApprovingReviewCount,
+			DismissStaleReviews:          reviews.DismissStaleReviews,
+			RequireCodeOwnerReviews:      reviews.RequireCodeOwnerReviews,
+		}
+	}
+	if protectionResp.EnforceAdmins != nil {
+		protection.EnforceAdmins = protectionResp.EnforceAdmins.Enabled
+	}
+	if restrictions := protectionResp.Restrictions; restrictions != nil {
+		protection.Restrictions = &BranchRestrictions{}
+		for _, user := range restrictions.Users {
+			protection.Restrictions.Users = append(protection.Restrictions.Users, user.Login)
+		}
+		for _, team := range restrictions.Teams {
+			protection.Restrictions.Teams = append(protection.Restrictions.Teams, team.Slug)
+		}
+		for _, app := range restrictions.Apps {
+			protection.Restrictions.Apps = append(protection.Restrictions.Apps, app.Slug)
+		}
+	}
+	return protection, nil
+}
+
+// UpdateBranchProtection replaces the protection of a branch, protecting it if it is not yet.
+// Parameters:
+//   - branch: The name of the branch.
+//   - rules: The protection rules, nil rules are disabled.
+//
+// Returns:
+//   - ErrInvalidRef if the branch is empty.
+//   - ErrGitHubAPI with the GitHub error body if the response status is not 200 OK, e.g. when
+//     restrictions are set on a repository that is not owned by an organization.
+//   - nil if the protection is successfully updated.
+func (g *git) UpdateBranchProtection(branch string, rules BranchProtection) error {
+	if branch == "" {
+		return ErrInvalidRef{Value: "branch is empty"}
+	}
+	reqBody := branchProtectionRequest{EnforceAdmins: rules.EnforceAdmins}
+	if checks := rules.RequiredStatusChecks; checks != nil {
+		reqBody.RequiredStatusChecks = &requiredStatusChecksRequest{
+			Strict:   checks.Strict,
+			Contexts: nonNil(checks.Contexts),
+		}
+	}
+	if reviews := rules.RequiredReviews; reviews != nil {
+		reqBody.RequiredReviews = &requiredReviewsRequest{
+			DismissStaleReviews:          reviews.DismissStaleReviews,
+			RequireCodeOwnerReviews:      reviews.RequireCodeOwnerReviews,
+			RequiredApprovingReviewCount: reviews.RequiredApprovingReviewCount,
+		}
+	}
+	if restrictions := rules.Restrictions; restrictions != nil {
+		reqBody.Restrictions = &branchRestrictionsRequest{
+			Users: nonNil(restrictions.Users),
+			Teams: nonNil(restrictions.Teams),
+			Apps:  nonNil(restrictions.Apps),
+		}
+	}
+	reqBodyJson, err := json.Marshal(reqBody)
+	if err != nil {
+		return err
+	}
+	resp, err := g.put("repos", g.branchProtectionPath(branch), nil, reqBodyJson)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 200 {
+		return apiError(resp)
+	}
+	return nil
+}
+
+func (g *git) branchProtectionPath(branch string) string {
+	return fmt.Sprintf("%s/%s/branches/%s/protection", g.cfg.Owner, g.cfg.Repo, url.PathEscape(branch))
+}
+
+// nonNil returns values, or an empty list when values is nil, as GitHub rejects null lists.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -1,12 +1,38 @@
 package git
 
-import "time"
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
 
 type Reviewers struct {
 	Users []string
 	Teams []string
 }
 
+// Repository is the metadata of a repository returned by GetRepository.
+type Repository struct {
+	Name          string      `json:"name"`
+	FullName      string      `json:"full_name"`
+	Description   string      `json:"description"`
+	DefaultBranch string      `json:"default_branch"`
+	Private       bool        `json:"private"`
+	Archived      bool        `json:"archived"`
+	Fork          bool        `json:"fork"`
+	HTMLUrl       string      `json:"html_url"`
+	Permissions   Permissions `json:"permissions"`
+}
+
+// Permissions are the permissions of the authenticated token on a repository.
+type Permissions struct {
+	Admin    bool `json:"admin"`
+	Maintain bool `json:"maintain"`
+	Push     bool `json:"push"`
+	Triage   bool `json:"triage"`
+	Pull     bool `json:"pull"`
+}
+
 type BranchInfo struct {
 	Ref    string `json:"ref"`
 	NodeId string `json:"node_id"`
@@ -33,6 +59,21 @@ type FileInfo struct {
 	Encoding    string `json:"encoding"`
 }
 
+// Types of the Contents returned by GetContents.
+const (
+	ContentTypeFile = "file"
+	ContentTypeDir  = "dir"
+)
+
+// Contents is a file or a directory of the repository.
+type Contents struct {
+	Type    string     // Type is ContentTypeFile or ContentTypeDir
+	Path    string     // Path is the requested path
+	File    *FileInfo  // File is the file metadata, set for files only
+	Content []byte     // Content is the decoded file content, set for files only
+	Entries []FileInfo // Entries are the directory entries, set for directories only
+}
+
 type FileResponse struct {
 	Content struct {
 		Name        string `json:"name"`
@@ -132,6 +173,15 @@ type CommitResponse struct {
 		Sha string `json:"sha"`
 		URL string `json:"url"`
 	} `json:"parents"`
+	Verification Verification `json:"verification"`
+}
+
+// Verification is the signature verification of a commit.
+type Verification struct {
+	Verified  bool   `json:"verified"`
+	Reason    string `json:"reason"`    // Reason is e.g. valid, unsigned or unknown_key
+	Signature string `json:"signature"` // Signature is the GPG or SSH signature, empty when unsigned
+	Payload   string `json:"payload"`   // Payload is the signed content of the commit
 }
 
 type RefResponse struct {
@@ -144,3 +194,180 @@ type RefResponse struct {
 		URL  string `json:"url"`
 	} `json:"object"`
 }
+
+// Review is a review submitted on a pull request.
+type Review struct {
+	ID          int64     `json:"id"`
+	User        User      `json:"user"`
+	State       string    `json:"state"` // APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED or PENDING
+	Body        string    `json:"body"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+// User is a GitHub user.
+type User struct {
+	Login string `json:"login"`
+	ID    int64  `json:"id"`
+}
+
+// WorkflowRunOptions filters the runs returned by ListWorkflowRuns. Empty fields are not filtered on.
+type WorkflowRunOptions struct {
+	Branch  string // Branch is the branch the runs were triggered on
+	Status  string // Status is a status or conclusion, e.g. in_progress, completed or success
+	Event   string // Event is the triggering event, e.g. push or workflow_dispatch
+	MaxRuns int    // MaxRuns is the most runs returned, 100 when zero
+}
+
+func (o WorkflowRunOptions) values() url.Values {
+	qs := url.Values{}
+	if o.Branch != "" {
+		qs.Set("branch", o.Branch)
+	}
+	if o.Status != "" {
+		qs.Set("status", o.Status)
+	}
+	if o.Event != "" {
+		qs.Set("event", o.Event)
+	}
+	return qs
+}
+
+// CommitListOptions filters the commits returned by ListCommits. Empty fields are not filtered on.
+type CommitListOptions struct {
+	Path       string    // Path only lists the commits touching this file or directory
+	Sha        string    // Sha is the branch or commit sha to list from, the default branch when empty
+	Since      time.Time // Since only lists the commits after this time
+	Until      time.Time // Until only lists the commits before this time
+	Author     string    // Author is a GitHub login or an email address
+	MaxCommits int       // MaxCommits is the most commits returned, 100 when zero
+}
+
+func (o CommitListOptions) values() url.Values {
+	qs := url.Values{}
+	if o.Path != "" {
+		qs.Set("path", o.Path)
+	}
+	if o.Sha != "" {
+		qs.Set("sha", o.Sha)
+	}
+	if !o.Since.IsZero() {
+		qs.Set("since", o.Since.UTC().Format(time.RFC3339))
+	}
+	if !o.Until.IsZero() {
+		qs.Set("until", o.Until.UTC().Format(time.RFC3339))
+	}
+	if o.Author != "" {
+		qs.Set("author", o.Author)
+	}
+	return qs
+}
+
+// listedCommit is a commit as listed by the commits endpoint, which nests the git commit.
+type listedCommit struct {
+	Sha     string         `json:"sha"`
+	NodeID  string         `json:"node_id"`
+	Commit  CommitResponse `json:"commit"`
+	Parents []struct {
+		Sha string `json:"sha"`
+		URL string `json:"url"`
+	} `json:"parents"`
+}
+
+// WorkflowRun is a run of a GitHub Actions workflow.
+type WorkflowRun struct {
+	ID         int64     `json:"id"`
+	Name       string    `json:"name"`
+	HeadBranch string    `json:"head_branch"`
+	HeadSha    string    `json:"head_sha"`
+	Event      string    `json:"event"`
+	Status     string    `json:"status"`     // queued, in_progress or completed
+	Conclusion string    `json:"conclusion"` // success, failure, cancelled, ... once completed
+	HTMLURL    string    `json:"html_url"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type workflowRunsResponse struct {
+	TotalCount   int           `json:"total_count"`
+	WorkflowRuns []WorkflowRun `json:"workflow_runs"`
+}
+
+// SearchOptions configures SearchIssues. Empty fields use the GitHub defaults.
+type SearchOptions struct {
+	Sort    string // Sort is e.g. created, updated or comments, best match when empty
+	Order   string // Order is asc or desc
+	PerPage int    // PerPage is the number of results per page, at most 100 (the default)
+	Page    int    // Page is the page of results, starting at 1
+}
+
+func (o SearchOptions) values(query string) url.Values {
+	qs := url.Values{}
+	qs.Set("q", query)
+	if o.Sort != "" {
+		qs.Set("sort", o.Sort)
+	}
+	if o.Order != "" {
+		qs.Set("order", o.Order)
+	}
+	if o.PerPage > 0 {
+		qs.Set("per_page", strconv.Itoa(o.PerPage))
+	} else {
+		qs.Set("per_page", strconv.Itoa(perPage))
+	}
+	if o.Page > 0 {
+		qs.Set("page", strconv.Itoa(o.Page))
+	}
+	return qs
+}
+
+// SearchResult is a page of the issues and pull requests matching a search.
+type SearchResult struct {
+	TotalCount        int           `json:"total_count"`
+	IncompleteResults bool          `json:"incomplete_results"` // IncompleteResults is true when the search timed out
+	Items             []SearchIssue `json:"items"`
+}
+
+// SearchIssue is an issue or pull request returned by SearchIssues.
+type SearchIssue struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	State   string `json:"state"`
+	HTMLURL string `json:"html_url"`
+	User    User   `json:"user"`
+}
+
+// RateLimit is the current API quota of the authenticated token.
+type RateLimit struct {
+	Core    Rate
+	Search  Rate
+	GraphQL Rate
+}
+
+// Rate is the quota of a single API resource.
+type Rate struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+type rateLimitResponse struct {
+	Resources struct {
+		Core    rateResponse `json:"core"`
+		Search  rateResponse `json:"search"`
+		GraphQL rateResponse `json:"graphql"`
+	} `json:"resources"`
+}
+
+type rateResponse struct {
+	Limit     int   `json:"limit"`
+	Remaining int   `json:"remaining"`
+	Reset     int64 `json:"reset"`
+}
+
+func (r rateResponse) rate() Rate {
+	return Rate{
+		Limit:     r.Limit,
+		Remaining: r.Remaining,
+		Reset:     time.Unix(r.Reset, 0),
+	}
+}
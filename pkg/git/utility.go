@@ -2,53 +2,69 @@ package git
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const (
 	baseUrl = "https://api.github.com"
 	accept  = "application/vnd.github+json"
-)
 
-func (g *git) get(basePath string, path string, qs url.Values) (*http.Response, error) {
-	uStr := g.cfg.BaseURL
-	if uStr == "" {
-		uStr = baseUrl
-	}
-	if len(basePath) > 0 {
-		uStr = fmt.Sprintf("%s/%s", uStr, basePath)
-	}
-	if len(path) > 0 {
-		uStr = fmt.Sprintf("%s/%s", uStr, path)
-	}
-	u, err := url.Parse(uStr)
-	if err != nil {
-		err = fmt.Errorf("failed to url parse %s: %v", uStr, err)
-		return nil, err
-	}
-	if qs != nil {
-		u.RawQuery = qs.Encode()
-	}
-	client := &http.Client{}
-	uStr = u.String()
+	apiVersionHeader = "X-GitHub-Api-Version"
+)
 
-	// fmt.Println(uStr)
-	req, err := http.NewRequest(http.MethodGet, uStr, nil)
-	if err != nil {
-		return nil, err
+// send sends the request, logs its outcome and reports it to the metrics hook.
+// Only the method and path are logged so that the token never ends up in the logs.
+func (g *git) send(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := g.client.Do(req)
+	dur := time.Since(start)
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	if g.cfg.Metrics != nil {
+		g.cfg.Metrics(req.Method, status, dur)
 	}
-	req.Header.Set("Accept", accept)
-	req.Header.Set("Authorization", "token "+g.cfg.Token)
-	resp, err := client.Do(req)
 	if err != nil {
+		g.cfg.Logger.Error(
+			"github request failed",
+			"method", req.Method,
+			"path", req.URL.Path,
+			"latency", dur,
+			"error", err,
+		)
 		return nil, err
 	}
+	if status >= http.StatusBadRequest {
+		g.cfg.Logger.Error(
+			"github request returned an error status",
+			"method", req.Method,
+			"path", req.URL.Path,
+			"status", status,
+			"latency", dur,
+		)
+		return resp, nil
+	}
+	g.cfg.Logger.Debug(
+		"github request succeeded",
+		"method", req.Method,
+		"path", req.URL.Path,
+		"status", status,
+		"latency", dur,
+	)
 	return resp, nil
 }
 
-func (g *git) post(basePath string, path string, qs url.Values, reqBody []byte) (*http.Response, error) {
+// do builds the API url from basePath, path and qs, sets the common headers and
+// sends the request with the given method and body.
+func (g *git) do(method string, basePath string, path string, qs url.Values, reqBody []byte) (*http.Response, error) {
 	uStr := g.cfg.BaseURL
 	if uStr == "" {
 		uStr = baseUrl
@@ -67,86 +83,161 @@ func (g *git) post(basePath string, path string, qs url.Values, reqBody []byte)
 	if qs != nil {
 		u.RawQuery = qs.Encode()
 	}
-	client := &http.Client{}
-	uStr = u.String()
-	req, err := http.NewRequest(http.MethodPost, uStr, bytes.NewBuffer(reqBody))
+	var body io.Reader
+	if reqBody != nil {
+		body = bytes.NewBuffer(reqBody)
+	}
+	req, err := http.NewRequestWithContext(g.cfg.Context, method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Accept", accept)
 	req.Header.Set("Authorization", "token "+g.cfg.Token)
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
+	if g.cfg.APIVersion != "" {
+		req.Header.Set(apiVersionHeader, g.cfg.APIVersion)
 	}
-	return resp, nil
+	return g.sendWithRetry(req)
 }
 
-func (g *git) put(basePath string, path string, qs url.Values, reqBody []byte) (*http.Response, error) {
-	uStr := g.cfg.BaseURL
-	if uStr == "" {
-		uStr = baseUrl
-	}
-	if len(basePath) > 0 {
-		uStr = uStr + "/" + basePath
-	}
-	if len(path) > 0 {
-		uStr = uStr + "/" + path
-	}
-	u, err := url.Parse(uStr)
-	if err != nil {
-		err = fmt.Errorf("failed to url parse %s: %v", uStr, err)
-		return nil, err
-	}
-	if qs != nil {
-		u.RawQuery = qs.Encode()
-	}
-	client := &http.Client{}
-	uStr = u.String()
-	// fmt.Println(uStr)
-	req, err := http.NewRequest(http.MethodPut, uStr, bytes.NewBuffer(reqBody))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Accept", accept)
-	req.Header.Set("Authorization", "token "+g.cfg.Token)
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
+// sendWithRetry sends the request, retrying up to Config.MaxRetries times when GitHub responds
+// with a 5xx status and the request is safe to repeat. Between attempts it sleeps for an
+// exponential backoff starting at Config.RetryBackoff, unless the request context is done first.
+func (g *git) sendWithRetry(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := g.send(req)
+		if err != nil || resp.StatusCode < http.StatusInternalServerError ||
+			attempt >= g.cfg.MaxRetries || !retryable(req) {
+			return resp, err
+		}
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(g.cfg.RetryBackoff << attempt)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+		g.cfg.Logger.Debug(
+			"retrying github request",
+			"method", req.Method,
+			"path", req.URL.Path,
+			"status", resp.StatusCode,
+			"attempt", attempt+1,
+		)
 	}
-	return resp, nil
+}
+
+// retryable reports whether req can be sent again after a 5xx without side effects. Besides GET,
+// HEAD and DELETE, creating git blobs, trees and commits is retryable: a repeated call creates the
+// same object or, for commits, an unreferenced one that is never part of a branch. PUT and PATCH
+// are not, as a 5xx does not tell whether e.g. a file commit or a reference update was applied.
+func retryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		for _, suffix := range []string{"/git/blobs", "/git/trees", "/git/commits"} {
+			if strings.HasSuffix(req.URL.Path, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasNextPage reports whether the Link header of a paginated response has a next page.
+func hasNextPage(resp *http.Response) bool {
+	for _, link := range strings.Split(resp.Header.Get("Link"), ",") {
+		if strings.Contains(link, `rel="next"`) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *git) get(basePath string, path string, qs url.Values) (*http.Response, error) {
+	return g.do(http.MethodGet, basePath, path, qs, nil)
+}
+
+func (g *git) post(basePath string, path string, qs url.Values, reqBody []byte) (*http.Response, error) {
+	return g.do(http.MethodPost, basePath, path, qs, reqBody)
+}
+
+func (g *git) put(basePath string, path string, qs url.Values, reqBody []byte) (*http.Response, error) {
+	return g.do(http.MethodPut, basePath, path, qs, reqBody)
 }
 
 func (g *git) patch(basePath string, path string, qs url.Values, reqBody []byte) (*http.Response, error) {
-	uStr := g.cfg.BaseURL
-	if uStr == "" {
-		uStr = baseUrl
+	return g.do(http.MethodPatch, basePath, path, qs, reqBody)
+}
+
+// rateLimited reports whether resp was rejected by the primary rate limit, when no request is
+// remaining, or by a secondary rate limit, which sets Retry-After.
+func rateLimited(resp *http.Response) (ErrRateLimited, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return ErrRateLimited{}, false
 	}
-	if len(basePath) > 0 {
-		uStr = uStr + "/" + basePath
+	if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return ErrRateLimited{Reset: time.Now().Add(time.Duration(retryAfter) * time.Second)}, true
 	}
-	if len(path) > 0 {
-		uStr = uStr + "/" + path
+	if resp.Header.Get("X-RateLimit-Remaining") != "0" {
+		return ErrRateLimited{}, false
 	}
-	u, err := url.Parse(uStr)
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
 	if err != nil {
-		err = fmt.Errorf("failed to url parse %s: %v", uStr, err)
-		return nil, err
+		return ErrRateLimited{}, true
 	}
-	if qs != nil {
-		u.RawQuery = qs.Encode()
-	}
-	client := &http.Client{}
-	uStr = u.String()
-	req, err := http.NewRequest(http.MethodPatch, uStr, bytes.NewBuffer(reqBody))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Accept", accept)
-	req.Header.Set("Authorization", "token "+g.cfg.Token)
-	resp, err := client.Do(req)
+	return ErrRateLimited{Reset: time.Unix(reset, 0)}, true
+}
+
+// apiError reads the error body of a rejected request into an ErrGitHubAPI. Validation
+// errors are reported by GitHub either as plain strings or as objects with a message or code.
+func apiError(resp *http.Response) error {
+	apiErr := ErrGitHubAPI{StatusCode: resp.StatusCode, Message: resp.Status}
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+		return apiErr
+	}
+	var errResp struct {
+		Message          string            `json:"message"`
+		Errors           []json.RawMessage `json:"errors"`
+		DocumentationURL string            `json:"documentation_url"`
+	}
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		return apiErr
+	}
+	if errResp.Message != "" {
+		apiErr.Message = errResp.Message
+	}
+	apiErr.DocumentationURL = errResp.DocumentationURL
+	for _, raw := range errResp.Errors {
+		var msg string
+		if err := json.Unmarshal(raw, &msg); err == nil {
+			apiErr.Errors = append(apiErr.Errors, msg)
+			continue
+		}
+		var detail struct {
+			Message string `json:"message"`
+			Code    string `json:"code"`
+		}
+		if err := json.Unmarshal(raw, &detail); err != nil {
+			continue
+		}
+		if detail.Message != "" {
+			apiErr.Errors = append(apiErr.Errors, detail.Message)
+		} else if detail.Code != "" {
+			apiErr.Errors = append(apiErr.Errors, detail.Code)
+		}
+	}
+	return apiErr
 }
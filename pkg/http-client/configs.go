@@ -0,0 +1,79 @@
+package http_client
+
+import (
+	"net/http"
+	"time"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Config holds the configuration of the http client.
+type Config struct {
+	// Client is the underlying http client used to send requests
+	Client *http.Client
+
+	// BaseURL is prepended to relative request urls
+	BaseURL string
+}
+
+// Option is a function that configures a Config.
+type Option func(cfg *Config)
+
+// WithOptions combines multiple options into a single option.
+func WithOptions(opts ...Option) Option {
+	return func(cfg *Config) {
+		for _, opt := range opts {
+			opt(cfg)
+		}
+	}
+}
+
+// WithHTTPClient sets the underlying http client used to send requests.
+// The client is copied, so options applied afterwards don't modify it.
+func WithHTTPClient(client *http.Client) Option {
+	if client == nil {
+		panic("http client is nil")
+	}
+	return func(cfg *Config) {
+		c := *client
+		cfg.Client = &c
+	}
+}
+
+// WithTimeout sets the timeout of every request. Defaults to 30s, zero means no timeout.
+func WithTimeout(timeout time.Duration) Option {
+	if timeout < 0 {
+		panic("timeout is negative")
+	}
+	return func(cfg *Config) {
+		cfg.Client.Timeout = timeout
+	}
+}
+
+// WithTransport sets the transport used to send requests, e.g. to configure TLS or proxies.
+func WithTransport(transport http.RoundTripper) Option {
+	if transport == nil {
+		panic("transport is nil")
+	}
+	return func(cfg *Config) {
+		cfg.Client.Transport = transport
+	}
+}
+
+// WithBaseURL sets a base url so that requests can be made with paths relative to it.
+func WithBaseURL(url string) Option {
+	if url == "" {
+		panic("base url is empty")
+	}
+	return func(cfg *Config) {
+		cfg.BaseURL = url
+	}
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Client: &http.Client{
+			Timeout: defaultTimeout,
+		},
+	}
+}
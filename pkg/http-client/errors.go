@@ -1,7 +1,20 @@
 package http_client
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	errInvalidUrl = errors.New("invalid url")
 )
+
+// StatusError is returned by the json helpers when the response status is not 2xx.
+type StatusError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", e.StatusCode, e.Body)
+}
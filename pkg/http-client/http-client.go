@@ -3,34 +3,71 @@ package http_client
 //go:generate mockgen -source=interface.go -destination=mocks/mock-http-client.go -package=mocks
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // New instance of httpClient
-func New() IHttpClient {
+//
+// Parameters:
+//   - opts: []Option [The options to configure the client]
+//
+// Returns:
+//   - IHttpClient: the http client
+func New(opts ...Option) IHttpClient {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(cfg)
+	}
 	return &httpClient{
-		client: &http.Client{},
+		client:  cfg.Client,
+		baseURL: cfg.BaseURL,
 	}
 }
 
-// Get a http request to url with headers
+// resolve returns the url prefixed with the base url when it is relative.
+func (hc *httpClient) resolve(rawURL string) string {
+	if hc.baseURL == "" {
+		return rawURL
+	}
+	if u, err := url.Parse(rawURL); err == nil && u.IsAbs() {
+		return rawURL
+	}
+	return strings.TrimRight(hc.baseURL, "/") + "/" + strings.TrimLeft(rawURL, "/")
+}
+
+// Do sends a http request with the given method, body and headers bound to ctx
 //
 // Parameters:
+//   - ctx: context.Context
+//   - method: string
 //   - url: string
+//   - body: []byte
 //   - headers: map[string]string
 //
 // Returns:
-//   - []byte: response body
-//   - int: response status code
+//   - *Response: response body, status code and headers
 //   - error: error
-func (hc *httpClient) Get(url string, headers map[string]string) ([]byte, int, error) {
+func (hc *httpClient) Do(
+	ctx context.Context,
+	method string,
+	url string,
+	body []byte,
+	headers map[string]string,
+) (*Response, error) {
 	if url == "" {
-		return nil, 0, errInvalidUrl
+		return nil, errInvalidUrl
 	}
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewBuffer(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, hc.resolve(url), reqBody)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	// Set headers
 	for key, value := range headers {
@@ -40,16 +77,68 @@ func (hc *httpClient) Get(url string, headers map[string]string) ([]byte, int, e
 	// Send request
 	resp, err := hc.client.Do(req)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	// Read response body
-	body, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{
+		Body:       respBody,
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+	}, nil
+}
+
+// do sends a http request through Do and returns the response body and status code.
+func (hc *httpClient) do(
+	ctx context.Context,
+	method string,
+	url string,
+	body []byte,
+	headers map[string]string,
+) ([]byte, int, error) {
+	resp, err := hc.Do(ctx, method, url, body, headers)
 	if err != nil {
 		return nil, 0, err
 	}
-	return body, resp.StatusCode, nil
+	return resp.Body, resp.StatusCode, nil
+}
+
+// Get a http request to url with headers
+//
+// Parameters:
+//   - url: string
+//   - headers: map[string]string
+//
+// Returns:
+//   - []byte: response body
+//   - int: response status code
+//   - error: error
+func (hc *httpClient) Get(url string, headers map[string]string) ([]byte, int, error) {
+	return hc.GetWithContext(context.Background(), url, headers)
+}
+
+// GetWithContext a http request to url with headers, bound to ctx
+//
+// Parameters:
+//   - ctx: context.Context
+//   - url: string
+//   - headers: map[string]string
+//
+// Returns:
+//   - []byte: response body
+//   - int: response status code
+//   - error: error
+func (hc *httpClient) GetWithContext(
+	ctx context.Context,
+	url string,
+	headers map[string]string,
+) ([]byte, int, error) {
+	return hc.do(ctx, http.MethodGet, url, nil, headers)
 }
 
 // Post a http request to url with headers
@@ -68,93 +157,106 @@ func (hc *httpClient) Post(
 	postBody []byte,
 	headers map[string]string,
 ) ([]byte, int, error) {
-	if url == "" {
-		return nil, 0, errInvalidUrl
-	}
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(postBody))
-	if err != nil {
-		return nil, 0, err
-	}
-	// Set headers
-	for key, value := range headers {
-		req.Header.Set(key, value)
-	}
-
-	// Send request
-	resp, err := hc.client.Do(req)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer resp.Body.Close()
+	return hc.PostWithContext(context.Background(), url, postBody, headers)
+}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, 0, err
-	}
-	return body, resp.StatusCode, nil
+// PostWithContext a http request to url with headers, bound to ctx
+//
+// Parameters:
+//   - ctx: context.Context
+//   - url: string
+//   - postBody: []byte
+//   - headers: map[string]string
+//
+// Returns:
+//   - []byte: response body
+//   - int: response status code
+//   - error: error
+func (hc *httpClient) PostWithContext(
+	ctx context.Context,
+	url string,
+	postBody []byte,
+	headers map[string]string,
+) ([]byte, int, error) {
+	return hc.do(ctx, http.MethodPost, url, postBody, headers)
 }
 
+// Put a http request to url with headers
+//
+// Parameters:
+//   - url: string
+//   - postBody: []byte
+//   - headers: map[string]string
+//
+// Returns:
+//   - []byte: response body
+//   - int: response status code
+//   - error: error
 func (hc *httpClient) Put(
 	url string,
 	postBody []byte,
 	headers map[string]string,
 ) ([]byte, int, error) {
-	if url == "" {
-		return nil, 0, errInvalidUrl
-	}
-	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(postBody))
-	if err != nil {
-		return nil, 0, err
-	}
-	// Set headers
-	for key, value := range headers {
-		req.Header.Set(key, value)
-	}
-
-	// Send request
-	resp, err := hc.client.Do(req)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer resp.Body.Close()
+	return hc.PutWithContext(context.Background(), url, postBody, headers)
+}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, 0, err
-	}
-	return body, resp.StatusCode, nil
+// PutWithContext a http request to url with headers, bound to ctx
+//
+// Parameters:
+//   - ctx: context.Context
+//   - url: string
+//   - postBody: []byte
+//   - headers: map[string]string
+//
+// Returns:
+//   - []byte: response body
+//   - int: response status code
+//   - error: error
+func (hc *httpClient) PutWithContext(
+	ctx context.Context,
+	url string,
+	postBody []byte,
+	headers map[string]string,
+) ([]byte, int, error) {
+	return hc.do(ctx, http.MethodPut, url, postBody, headers)
 }
 
+// Delete a http request to url with headers
+//
+// Parameters:
+//   - url: string
+//   - postBody: []byte
+//   - headers: map[string]string
+//
+// Returns:
+//   - []byte: response body
+//   - int: response status code
+//   - error: error
 func (hc *httpClient) Delete(
 	url string,
 	postBody []byte,
 	headers map[string]string,
 ) ([]byte, int, error) {
-	if url == "" {
-		return nil, 0, errInvalidUrl
-	}
-	req, err := http.NewRequest(http.MethodDelete, url, bytes.NewBuffer(postBody))
-	if err != nil {
-		return nil, 0, err
-	}
-	// Set headers
-	for key, value := range headers {
-		req.Header.Set(key, value)
-	}
-
-	// Send request
-	resp, err := hc.client.Do(req)
-	if err != nil {
-		return nil, 0, err
-	}
-	defer resp.Body.Close()
+	return hc.DeleteWithContext(context.Background(), url, postBody, headers)
+}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, 0, err
-	}
-	return body, resp.StatusCode, nil
+// DeleteWithContext a http request to url with headers, bound to ctx
+//
+// Parameters:
+//   - ctx: context.Context
+//   - url: string
+//   - postBody: []byte
+//   - headers: map[string]string
+//
+// Returns:
+//   - []byte: response body
+//   - int: response status code
+//   - error: error
+func (hc *httpClient) DeleteWithContext(
+	ctx context.Context,
+	url string,
+	postBody []byte,
+	headers map[string]string,
+) ([]byte, int, error) {
+	return hc.do(ctx, http.MethodDelete, url, postBody, headers)
 }
@@ -0,0 +1,332 @@
+package http_client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	http_client "github.com/pal-paul/go-libraries/pkg/http-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMockServer(
+	t *testing.T,
+	method string,
+	expectedBody string,
+	status int,
+	response []byte,
+) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, method, r.Method, "Method mismatch. Expected: %s, Got: %s", method, r.Method)
+		assert.Equal(t, "test-value", r.Header.Get("X-Test-Header"), "Header mismatch")
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedBody, string(body))
+
+		w.WriteHeader(status)
+		if response != nil {
+			w.Write(response)
+		}
+	}))
+}
+
+func TestHttpClient(t *testing.T) {
+	headers := map[string]string{
+		"X-Test-Header": "test-value",
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		body     []byte
+		status   int
+		response []byte
+	}{
+		{
+			name:     "get",
+			method:   http.MethodGet,
+			status:   http.StatusOK,
+			response: []byte(`{"id": 1}`),
+		},
+		{
+			name:     "post",
+			method:   http.MethodPost,
+			body:     []byte(`{"name": "test"}`),
+			status:   http.StatusCreated,
+			response: []byte(`{"id": 1}`),
+		},
+		{
+			name:     "put",
+			method:   http.MethodPut,
+			body:     []byte(`{"name": "updated"}`),
+			status:   http.StatusOK,
+			response: []byte(`{"id": 1}`),
+		},
+		{
+			name:   "delete",
+			method: http.MethodDelete,
+			status: http.StatusNoContent,
+		},
+		{
+			name:     "error status is returned without error",
+			method:   http.MethodGet,
+			status:   http.StatusNotFound,
+			response: []byte(`{"message": "not found"}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupMockServer(t, tt.method, string(tt.body), tt.status, tt.response)
+			defer server.Close()
+
+			client := http_client.New(http_client.WithHTTPClient(server.Client()))
+
+			var (
+				body   []byte
+				status int
+				err    error
+			)
+			switch tt.method {
+			case http.MethodGet:
+				body, status, err = client.Get(server.URL, headers)
+			case http.MethodPost:
+				body, status, err = client.Post(server.URL, tt.body, headers)
+			case http.MethodPut:
+				body, status, err = client.Put(server.URL, tt.body, headers)
+			case http.MethodDelete:
+				body, status, err = client.Delete(server.URL, tt.body, headers)
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, status)
+			assert.Equal(t, string(tt.response), string(body))
+		})
+	}
+}
+
+func TestHttpClientInvalidUrl(t *testing.T) {
+	client := http_client.New()
+
+	_, _, err := client.Get("", nil)
+	assert.Error(t, err)
+	_, _, err = client.Post("", nil, nil)
+	assert.Error(t, err)
+	_, _, err = client.Put("", nil, nil)
+	assert.Error(t, err)
+	_, _, err = client.Delete("", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestHttpClientOptions(t *testing.T) {
+	t.Run("relative path with base url", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/users", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := http_client.New(http_client.WithBaseURL(server.URL + "/api/"))
+
+		_, status, err := client.Get("/users", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("absolute url ignores base url", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/users", r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := http_client.New(http_client.WithBaseURL("http://example.invalid/api"))
+
+		_, status, err := client.Get(server.URL+"/users", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-release:
+			}
+		}))
+		defer server.Close()
+		defer close(release)
+
+		client := http_client.New(http_client.WithTimeout(50 * time.Millisecond))
+
+		_, _, err := client.Get(server.URL, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("transport", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "added-by-transport", r.Header.Get("X-Transport"))
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := http_client.New(http_client.WithTransport(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			r.Header.Set("X-Transport", "added-by-transport")
+			return http.DefaultTransport.RoundTrip(r)
+		})))
+
+		_, status, err := client.Get(server.URL, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, status)
+	})
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestHttpClientWithContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := http_client.New()
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context) error
+	}{
+		{
+			name: "get",
+			call: func(ctx context.Context) error {
+				_, _, err := client.GetWithContext(ctx, server.URL, nil)
+				return err
+			},
+		},
+		{
+			name: "post",
+			call: func(ctx context.Context) error {
+				_, _, err := client.PostWithContext(ctx, server.URL, []byte(`{}`), nil)
+				return err
+			},
+		},
+		{
+			name: "put",
+			call: func(ctx context.Context) error {
+				_, _, err := client.PutWithContext(ctx, server.URL, []byte(`{}`), nil)
+				return err
+			},
+		},
+		{
+			name: "delete",
+			call: func(ctx context.Context) error {
+				_, _, err := client.DeleteWithContext(ctx, server.URL, nil, nil)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			err := tt.call(ctx)
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		})
+	}
+}
+
+func TestJSONHelpers(t *testing.T) {
+	type user struct {
+		ID    int    `json:"id"`
+		Name  string `json:"name"`
+		Error string `json:"error"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		switch {
+		case r.Method == http.MethodPost:
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"id":0,"name":"test","error":""}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": 1, "name": "test"}`))
+		case r.URL.Path == "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "not found"}`))
+		default:
+			w.Write([]byte(`{"id": 1, "name": "test"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := http_client.New(http_client.WithBaseURL(server.URL))
+
+	t.Run("post", func(t *testing.T) {
+		got, status, err := http_client.PostJSON[user](client, "/users", user{Name: "test"}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, status)
+		assert.Equal(t, user{ID: 1, Name: "test"}, got)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		got, status, err := http_client.GetJSON[user](client, "/users/1", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, user{ID: 1, Name: "test"}, got)
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		got, status, err := http_client.GetJSON[user](client, "/missing", nil)
+		assert.Equal(t, http.StatusNotFound, status)
+		assert.Equal(t, "not found", got.Error)
+		var statusErr *http_client.StatusError
+		assert.ErrorAs(t, err, &statusErr)
+		assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		_, _, err := http_client.PostJSON[user](client, "/users", func() {}, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestHttpClientDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"name": "test"}`, string(body))
+		w.Header().Set("ETag", `"abc"`)
+		w.Header().Set("Location", "/users/1")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	client := http_client.New()
+	resp, err := client.Do(context.Background(), http.MethodPatch, server.URL, []byte(`{"name": "test"}`), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, `{"id": 1}`, string(resp.Body))
+	assert.Equal(t, `"abc"`, resp.Headers.Get("ETag"))
+	assert.Equal(t, "/users/1", resp.Headers.Get("Location"))
+
+	_, err = client.Do(context.Background(), http.MethodGet, "", nil, nil)
+	assert.Error(t, err)
+}
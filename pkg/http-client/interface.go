@@ -1,11 +1,20 @@
 package http_client
 
 import (
+	"context"
 	"net/http"
 )
 
 type httpClient struct {
-	client *http.Client
+	client  *http.Client
+	baseURL string
+}
+
+// Response is a http response with its body, status code and headers.
+type Response struct {
+	Body       []byte
+	StatusCode int
+	Headers    http.Header
 }
 
 type IHttpClient interface {
@@ -21,6 +30,19 @@ type IHttpClient interface {
 	//   - error: error
 	Get(url string, headers map[string]string) ([]byte, int, error)
 
+	// GetWithContext a http request to url with headers, bound to ctx
+	//
+	// Parameters:
+	//   - ctx: context.Context
+	//   - url: string
+	//   - headers: map[string]string
+	//
+	// Returns:
+	//   - []byte: response body
+	//   - int: response status code
+	//   - error: error
+	GetWithContext(ctx context.Context, url string, headers map[string]string) ([]byte, int, error)
+
 	// Post a http request to url with headers
 	//
 	// Parameters:
@@ -34,6 +56,20 @@ type IHttpClient interface {
 	//   - error: error
 	Post(url string, postBody []byte, headers map[string]string) ([]byte, int, error)
 
+	// PostWithContext a http request to url with headers, bound to ctx
+	//
+	// Parameters:
+	//   - ctx: context.Context
+	//   - url: string
+	//   - postBody: []byte
+	//   - headers: map[string]string
+	//
+	// Returns:
+	//   - []byte: response body
+	//   - int: response status code
+	//   - error: error
+	PostWithContext(ctx context.Context, url string, postBody []byte, headers map[string]string) ([]byte, int, error)
+
 	// Put a http request to url with headers
 	//
 	// Parameters:
@@ -47,6 +83,20 @@ type IHttpClient interface {
 	//   - error: error
 	Put(url string, postBody []byte, headers map[string]string) ([]byte, int, error)
 
+	// PutWithContext a http request to url with headers, bound to ctx
+	//
+	// Parameters:
+	//   - ctx: context.Context
+	//   - url: string
+	//   - postBody: []byte
+	//   - headers: map[string]string
+	//
+	// Returns:
+	//   - []byte: response body
+	//   - int: response status code
+	//   - error: error
+	PutWithContext(ctx context.Context, url string, postBody []byte, headers map[string]string) ([]byte, int, error)
+
 	// Delete a http request to url with headers
 	//
 	// Parameters:
@@ -59,4 +109,32 @@ type IHttpClient interface {
 	//   - int: response status code
 	//   - error: error
 	Delete(url string, postBody []byte, headers map[string]string) ([]byte, int, error)
+
+	// DeleteWithContext a http request to url with headers, bound to ctx
+	//
+	// Parameters:
+	//   - ctx: context.Context
+	//   - url: string
+	//   - postBody: []byte
+	//   - headers: map[string]string
+	//
+	// Returns:
+	//   - []byte: response body
+	//   - int: response status code
+	//   - error: error
+	DeleteWithContext(ctx context.Context, url string, postBody []byte, headers map[string]string) ([]byte, int, error)
+
+	// Do sends a http request with the given method, body and headers bound to ctx
+	//
+	// Parameters:
+	//   - ctx: context.Context
+	//   - method: string
+	//   - url: string
+	//   - body: []byte
+	//   - headers: map[string]string
+	//
+	// Returns:
+	//   - *Response: response body, status code and headers
+	//   - error: error
+	Do(ctx context.Context, method string, url string, body []byte, headers map[string]string) (*Response, error)
 }
@@ -0,0 +1,84 @@
+package http_client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// PostJSON marshals body as json, posts it to url and decodes the response into T.
+// A non-2xx response is decoded into T when possible and returned with a *StatusError.
+//
+// Parameters:
+//   - hc: IHttpClient [The client used to send the request]
+//   - url: string
+//   - body: any [The value to marshal as the request body]
+//   - headers: map[string]string
+//
+// Returns:
+//   - T: decoded response body
+//   - int: response status code
+//   - error: error
+func PostJSON[T any](hc IHttpClient, url string, body any, headers map[string]string) (T, int, error) {
+	var out T
+	postBody, err := json.Marshal(body)
+	if err != nil {
+		return out, 0, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	headers = jsonHeaders(headers)
+	headers["Content-Type"] = "application/json"
+	respBody, status, err := hc.Post(url, postBody, headers)
+	if err != nil {
+		return out, status, err
+	}
+	return decodeJSON[T](respBody, status)
+}
+
+// GetJSON sends a get request to url and decodes the response into T.
+// A non-2xx response is decoded into T when possible and returned with a *StatusError.
+//
+// Parameters:
+//   - hc: IHttpClient [The client used to send the request]
+//   - url: string
+//   - headers: map[string]string
+//
+// Returns:
+//   - T: decoded response body
+//   - int: response status code
+//   - error: error
+func GetJSON[T any](hc IHttpClient, url string, headers map[string]string) (T, int, error) {
+	var out T
+	respBody, status, err := hc.Get(url, jsonHeaders(headers))
+	if err != nil {
+		return out, status, err
+	}
+	return decodeJSON[T](respBody, status)
+}
+
+// jsonHeaders returns a copy of headers that accepts json responses.
+func jsonHeaders(headers map[string]string) map[string]string {
+	out := make(map[string]string, len(headers)+2)
+	for key, value := range headers {
+		out[key] = value
+	}
+	if _, ok := out["Accept"]; !ok {
+		out["Accept"] = "application/json"
+	}
+	return out
+}
+
+// decodeJSON decodes body into T and reports non-2xx status codes as a *StatusError.
+func decodeJSON[T any](body []byte, status int) (T, int, error) {
+	var out T
+	var decodeErr error
+	if len(body) > 0 {
+		decodeErr = json.Unmarshal(body, &out)
+	}
+	if status < http.StatusOK || status >= http.StatusMultipleChoices {
+		return out, status, &StatusError{StatusCode: status, Body: body}
+	}
+	if decodeErr != nil {
+		return out, status, fmt.Errorf("failed to decode response body: %w", decodeErr)
+	}
+	return out, status, nil
+}
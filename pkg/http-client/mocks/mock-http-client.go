@@ -10,8 +10,10 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
+	http_client "github.com/pal-paul/go-libraries/pkg/http-client"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,6 +57,37 @@ func (mr *MockIHttpClientMockRecorder) Delete(url, postBody, headers any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIHttpClient)(nil).Delete), url, postBody, headers)
 }
 
+// DeleteWithContext mocks base method.
+func (m *MockIHttpClient) DeleteWithContext(ctx context.Context, url string, postBody []byte, headers map[string]string) ([]byte, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithContext", ctx, url, postBody, headers)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeleteWithContext indicates an expected call of DeleteWithContext.
+func (mr *MockIHttpClientMockRecorder) DeleteWithContext(ctx, url, postBody, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithContext", reflect.TypeOf((*MockIHttpClient)(nil).DeleteWithContext), ctx, url, postBody, headers)
+}
+
+// Do mocks base method.
+func (m *MockIHttpClient) Do(ctx context.Context, method, url string, body []byte, headers map[string]string) (*http_client.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, method, url, body, headers)
+	ret0, _ := ret[0].(*http_client.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIHttpClientMockRecorder) Do(ctx, method, url, body, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIHttpClient)(nil).Do), ctx, method, url, body, headers)
+}
+
 // Get mocks base method.
 func (m *MockIHttpClient) Get(url string, headers map[string]string) ([]byte, int, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +104,22 @@ func (mr *MockIHttpClientMockRecorder) Get(url, headers any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIHttpClient)(nil).Get), url, headers)
 }
 
+// GetWithContext mocks base method.
+func (m *MockIHttpClient) GetWithContext(ctx context.Context, url string, headers map[string]string) ([]byte, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithContext", ctx, url, headers)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWithContext indicates an expected call of GetWithContext.
+func (mr *MockIHttpClientMockRecorder) GetWithContext(ctx, url, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithContext", reflect.TypeOf((*MockIHttpClient)(nil).GetWithContext), ctx, url, headers)
+}
+
 // Post mocks base method.
 func (m *MockIHttpClient) Post(url string, postBody []byte, headers map[string]string) ([]byte, int, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +136,22 @@ func (mr *MockIHttpClientMockRecorder) Post(url, postBody, headers any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*MockIHttpClient)(nil).Post), url, postBody, headers)
 }
 
+// PostWithContext mocks base method.
+func (m *MockIHttpClient) PostWithContext(ctx context.Context, url string, postBody []byte, headers map[string]string) ([]byte, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostWithContext", ctx, url, postBody, headers)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PostWithContext indicates an expected call of PostWithContext.
+func (mr *MockIHttpClientMockRecorder) PostWithContext(ctx, url, postBody, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostWithContext", reflect.TypeOf((*MockIHttpClient)(nil).PostWithContext), ctx, url, postBody, headers)
+}
+
 // Put mocks base method.
 func (m *MockIHttpClient) Put(url string, postBody []byte, headers map[string]string) ([]byte, int, error) {
 	m.ctrl.T.Helper()
@@ -102,3 +167,19 @@ func (mr *MockIHttpClientMockRecorder) Put(url, postBody, headers any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockIHttpClient)(nil).Put), url, postBody, headers)
 }
+
+// PutWithContext mocks base method.
+func (m *MockIHttpClient) PutWithContext(ctx context.Context, url string, postBody []byte, headers map[string]string) ([]byte, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWithContext", ctx, url, postBody, headers)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PutWithContext indicates an expected call of PutWithContext.
+func (mr *MockIHttpClientMockRecorder) PutWithContext(ctx, url, postBody, headers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithContext", reflect.TypeOf((*MockIHttpClient)(nil).PutWithContext), ctx, url, postBody, headers)
+}
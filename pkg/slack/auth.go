@@ -0,0 +1,30 @@
+package slack
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+)
+
+// AuthTest checks the configured token and returns the identity it belongs to.
+func (s *slack) AuthTest() (*AuthInfo, error) {
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	resp, err := s.postForm("auth.test", headers, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var response AuthTestResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	if !response.Ok {
+		return nil, responseError(response.SlackResponse, "")
+	}
+	return &response.AuthInfo, nil
+}
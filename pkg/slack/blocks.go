@@ -0,0 +1,103 @@
+package slack
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Limits of the Block Kit blocks, see https://api.slack.com/reference/block-kit/blocks
+const (
+	maxBlocks            = 50
+	maxBlockIDLength     = 255
+	maxSectionTextLength = 3000
+	maxSectionFields     = 10
+	maxFieldTextLength   = 2000
+	maxHeaderTextLength  = 150
+	maxImageURLLength    = 3000
+	maxAltTextLength     = 2000
+	maxImageTitleLength  = 2000
+	maxActionElements    = 25
+)
+
+// ValidateBlocks checks blocks against the Block Kit limits Slack enforces, so a malformed
+// message fails with an error naming the block instead of an opaque invalid_blocks response.
+// Blocks of a type without a constant in this package are not checked.
+func ValidateBlocks(blocks []Block) error {
+	if len(blocks) > maxBlocks {
+		return &ErrInvalidBlock{
+			Index: maxBlocks,
+			Value: fmt.Sprintf("a message has at most %d blocks, got %d", maxBlocks, len(blocks)),
+		}
+	}
+	for i, block := range blocks {
+		if reason := validateBlock(block); reason != "" {
+			return &ErrInvalidBlock{Index: i, Value: fmt.Sprintf("%s block %s", block.Type, reason)}
+		}
+	}
+	return nil
+}
+
+// validateBlock returns why block is invalid, or an empty string when it is valid.
+func validateBlock(block Block) string {
+	if block.Type == "" {
+		return "has no type"
+	}
+	if utf8.RuneCountInString(block.BlockId) > maxBlockIDLength {
+		return fmt.Sprintf("block_id exceeds %d characters", maxBlockIDLength)
+	}
+	switch block.Type {
+	case SectionBlock:
+		if (block.Text == nil || block.Text.Text == "") && len(block.Fields) == 0 {
+			return "has neither text nor fields"
+		}
+		if block.Text != nil && utf8.RuneCountInString(block.Text.Text) > maxSectionTextLength {
+			return fmt.Sprintf("text exceeds %d characters", maxSectionTextLength)
+		}
+		if len(block.Fields) > maxSectionFields {
+			return fmt.Sprintf("has more than %d fields", maxSectionFields)
+		}
+		for j, field := range block.Fields {
+			if utf8.RuneCountInString(field.Text) > maxFieldTextLength {
+				return fmt.Sprintf("field %d exceeds %d characters", j, maxFieldTextLength)
+			}
+		}
+	case HeaderBlock:
+		if block.Text == nil || block.Text.Text == "" {
+			return "has no text"
+		}
+		if block.Text.Type != PlainText {
+			return "text must be plain_text"
+		}
+		if utf8.RuneCountInString(block.Text.Text) > maxHeaderTextLength {
+			return fmt.Sprintf("text exceeds %d characters", maxHeaderTextLength)
+		}
+	case ImageBlock:
+		if block.ImageURL == "" {
+			return "has no image_url"
+		}
+		if utf8.RuneCountInString(block.ImageURL) > maxImageURLLength {
+			return fmt.Sprintf("image_url exceeds %d characters", maxImageURLLength)
+		}
+		if block.AltText == "" {
+			return "has no alt_text"
+		}
+		if utf8.RuneCountInString(block.AltText) > maxAltTextLength {
+			return fmt.Sprintf("alt_text exceeds %d characters", maxAltTextLength)
+		}
+		if block.Title != nil && utf8.RuneCountInString(block.Title.Text) > maxImageTitleLength {
+			return fmt.Sprintf("title exceeds %d characters", maxImageTitleLength)
+		}
+	case ActionsBlock:
+		if len(block.Elements) == 0 {
+			return "has no elements"
+		}
+		if len(block.Elements) > maxActionElements {
+			return fmt.Sprintf("has more than %d elements", maxActionElements)
+		}
+	case RichTextBlock:
+		if len(block.Elements) == 0 {
+			return "has no elements"
+		}
+	}
+	return ""
+}
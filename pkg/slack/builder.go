@@ -0,0 +1,121 @@
+package slack
+
+// MessageBuilder builds a Message block by block, e.g.
+//
+//	message := slack.NewMessageBuilder().
+//		Text("Deploy finished").
+//		Header("Deploy").
+//		Section("*api* is live in _production_").
+//		Divider().
+//		Actions(slack.NewButton("Rollback", "rollback", "api", "danger")).
+//		Build()
+type MessageBuilder struct {
+	message Message
+}
+
+// NewMessageBuilder returns a builder of an empty message.
+func NewMessageBuilder() *MessageBuilder {
+	return &MessageBuilder{}
+}
+
+// Text sets the plain text of the message, shown in notifications and by clients that can't
+// render blocks.
+func (b *MessageBuilder) Text(text string) *MessageBuilder {
+	b.message.Text = text
+	return b
+}
+
+// Thread makes the message a reply in the thread of the message with the timestamp ts.
+func (b *MessageBuilder) Thread(ts string) *MessageBuilder {
+	b.message.Thread = ts
+	return b
+}
+
+// Broadcast also shows the reply set with Thread in the channel.
+func (b *MessageBuilder) Broadcast() *MessageBuilder {
+	b.message.ReplyBroadcast = true
+	return b
+}
+
+// Header adds a header block with plain text.
+func (b *MessageBuilder) Header(text string) *MessageBuilder {
+	return b.Block(Block{
+		Type: HeaderBlock,
+		Text: &Text{Type: PlainText, Text: text, Emoji: true},
+	})
+}
+
+// Section adds a section block with markdown text.
+func (b *MessageBuilder) Section(markdown string) *MessageBuilder {
+	return b.Block(Block{
+		Type: SectionBlock,
+		Text: &Text{Type: Mrkdwn, Text: markdown},
+	})
+}
+
+// Fields adds a section block with a markdown field per value, shown in two columns.
+func (b *MessageBuilder) Fields(markdown ...string) *MessageBuilder {
+	fields := make([]Field, len(markdown))
+	for i, text := range markdown {
+		fields[i] = Field{Type: Mrkdwn, Text: text}
+	}
+	return b.Block(Block{
+		Type:   SectionBlock,
+		Fields: fields,
+	})
+}
+
+// Divider adds a divider block.
+func (b *MessageBuilder) Divider() *MessageBuilder {
+	return b.Block(Block{Type: DividerBlock})
+}
+
+// Image adds an image block.
+func (b *MessageBuilder) Image(imageURL string, altText string) *MessageBuilder {
+	return b.Block(Block{
+		Type:     ImageBlock,
+		ImageURL: imageURL,
+		AltText:  altText,
+	})
+}
+
+// Actions adds an actions block with interactive elements, e.g. buttons made with NewButton.
+func (b *MessageBuilder) Actions(elements ...Element) *MessageBuilder {
+	return b.Block(Block{
+		Type:     ActionsBlock,
+		Elements: elements,
+	})
+}
+
+// Block adds a block built by hand, for the blocks the builder has no method for.
+func (b *MessageBuilder) Block(block Block) *MessageBuilder {
+	b.message.Blocks = append(b.message.Blocks, block)
+	return b
+}
+
+// Attachment adds a legacy attachment, e.g. a card with a colored bar, shown below the blocks.
+func (b *MessageBuilder) Attachment(attachment Attachment) *MessageBuilder {
+	b.message.Attachments = append(b.message.Attachments, attachment)
+	return b
+}
+
+// Build returns the message. The blocks can be checked with ValidateBlocks, which the message
+// operations of the client also do before sending.
+func (b *MessageBuilder) Build() Message {
+	message := b.message
+	message.Blocks = append([]Block(nil), b.message.Blocks...)
+	message.Attachments = append([]Attachment(nil), b.message.Attachments...)
+	return message
+}
+
+// NewButton returns a button element for an actions block. style is empty for the default
+// style, "primary" or "danger".
+func NewButton(text string, actionID string, value string, style string) Element {
+	return Element{
+		Type:     string(Button),
+		Text:     &Text{Type: PlainText, Text: text, Emoji: true},
+		ActionId: actionID,
+		Value:    value,
+		Style:    style,
+	}
+}
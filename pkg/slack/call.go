@@ -0,0 +1,38 @@
+package slack
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+)
+
+// CallMethod calls any Slack web API method with form encoded params, for the methods the
+// client has no first-class support for. The call is authenticated, rate limited and retried
+// like every other call of the client.
+func (s *slack) CallMethod(method string, params url.Values) (*SlackResponse, json.RawMessage, error) {
+	if method == "" {
+		return nil, nil, &ErrSlackResponse{Value: "method is empty"}
+	}
+	if params == nil {
+		params = url.Values{}
+	}
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	resp, err := s.postForm(method, headers, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var response SlackResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, nil, err
+	}
+	if !response.Ok {
+		return &response, body, responseError(response, params.Get("channel"))
+	}
+	return &response, body, nil
+}
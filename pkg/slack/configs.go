@@ -1,11 +1,25 @@
 package slack
 
-import "context"
+import (
+	"context"
+	"net/http"
+	"time"
+)
 
 type Config struct {
 	Token   string
 	BaseURL string
 	Context context.Context
+	Logger  Logger
+
+	MaxRetries int
+	Limiter    *Limiter
+	HTTPClient *http.Client
+	Timeout    time.Duration
+
+	MaxUploadSize int64
+
+	AutoResolveChannels bool
 }
 
 // Option is a function that configures a Config.
@@ -32,9 +46,69 @@ func WithBaseURL(url string) Option {
 	}
 }
 
+// WithLogger sets the logger used to log every API request. A nil logger disables logging.
+func WithLogger(l Logger) Option {
+	return func(cfg *Config) {
+		if l == nil {
+			l = noopLogger{}
+		}
+		cfg.Logger = l
+	}
+}
+
+// WithMaxRetries sets how many times a request that was rate limited by slack
+// is retried after sleeping for the Retry-After duration. Defaults to 0 (no retries).
+func WithMaxRetries(n int) Option {
+	return func(cfg *Config) {
+		cfg.MaxRetries = n
+	}
+}
+
+// WithSharedLimiter sets a rate limiter that every request waits on before it is sent.
+// The same Limiter can be passed to several clients to share a single rate limit budget.
+func WithSharedLimiter(l *Limiter) Option {
+	return func(cfg *Config) {
+		cfg.Limiter = l
+	}
+}
+
+// WithHTTPClient sets the http client used to send API requests, e.g. to tune its transport.
+func WithHTTPClient(client *http.Client) Option {
+	return func(cfg *Config) {
+		cfg.HTTPClient = client
+	}
+}
+
+// WithTimeout sets a timeout for every API request, including file uploads.
+func WithTimeout(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.Timeout = d
+	}
+}
+
+// WithMaxUploadSize sets the largest content, in bytes, that file uploads accept, for both
+// files.upload and the external upload flow of UploadFile. Larger content fails with
+// ErrFileUploadFailed without being sent. Defaults to 1GB, Slack's limit.
+func WithMaxUploadSize(n int64) Option {
+	return func(cfg *Config) {
+		cfg.MaxUploadSize = n
+	}
+}
+
+// WithAutoResolveChannels resolves channel names, e.g. "#deploys" or "deploys", to channel IDs
+// before every request, using the cache of GetChannelIDByName. Channel IDs and user IDs, which
+// post in a direct message, are used as is.
+func WithAutoResolveChannels() Option {
+	return func(cfg *Config) {
+		cfg.AutoResolveChannels = true
+	}
+}
+
 func defaultConfig() *Config {
 	return &Config{
-		BaseURL: baseUrl,
-		Context: context.Background(),
+		BaseURL:       baseUrl,
+		Context:       context.Background(),
+		Logger:        noopLogger{},
+		MaxUploadSize: defaultMaxUploadSize,
 	}
 }
@@ -0,0 +1,205 @@
+package slack
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// channelIDPattern matches the IDs of public channels (C), private channels (G) and direct
+// messages (D), and the user IDs (U, W) chat.postMessage accepts to post in a direct message.
+var channelIDPattern = regexp.MustCompile(`^[CGDUW][A-Z0-9]{8,}$`)
+
+// ListBotChannels returns the IDs of all public and private channels the bot is a member of.
+func (s *slack) ListBotChannels() ([]string, error) {
+	conversations, err := s.listConversations("users.conversations")
+	if err != nil {
+		return nil, err
+	}
+	channels := make([]string, 0, len(conversations))
+	for _, channel := range conversations {
+		channels = append(channels, channel.ID)
+	}
+	return channels, nil
+}
+
+// GetChannelIDByName returns the ID of the public or private channel with the given name.
+// A leading "#" is ignored. The name to ID mapping is cached for the lifetime of the client,
+// so the channels are only listed again when a name is not cached yet. Unknown names are cached
+// too, so a channel created after its name was looked up is not found by the same client.
+func (s *slack) GetChannelIDByName(name string) (string, error) {
+	name = strings.TrimPrefix(name, "#")
+	if name == "" {
+		return "", &ErrInvalidChannel{Value: "channel name is empty"}
+	}
+
+	s.channelsMu.Lock()
+	id, ok := s.channelIDs[name]
+	s.channelsMu.Unlock()
+	if !ok {
+		// the lock is not held while listing, so concurrent lookups may list the channels twice
+		conversations, err := s.listConversations("conversations.list")
+		if err != nil {
+			return "", err
+		}
+		s.channelsMu.Lock()
+		if s.channelIDs == nil {
+			s.channelIDs = make(map[string]string, len(conversations))
+		}
+		for _, channel := range conversations {
+			s.channelIDs[channel.Name] = channel.ID
+		}
+		if _, ok := s.channelIDs[name]; !ok {
+			s.channelIDs[name] = ""
+		}
+		id = s.channelIDs[name]
+		s.channelsMu.Unlock()
+	}
+	if id == "" {
+		return "", &ErrInvalidChannel{Value: name}
+	}
+	return id, nil
+}
+
+// resolveChannel returns the ID of channel when WithAutoResolveChannels is enabled and channel
+// is a name rather than a channel or user ID, otherwise channel is returned unchanged.
+func (s *slack) resolveChannel(channel string) (string, error) {
+	if !s.cfg.AutoResolveChannels || channel == "" || channelIDPattern.MatchString(channel) {
+		return channel, nil
+	}
+	return s.GetChannelIDByName(channel)
+}
+
+// defaultHistoryLimit is the number of messages GetChannelHistory reads when no limit is given,
+// and historyPageSize the maximum number of messages requested per page.
+const (
+	defaultHistoryLimit = 100
+	historyPageSize     = 200
+)
+
+// GetChannelHistory returns up to limit of the most recent messages of a channel, newest first,
+// following the pagination cursor. A non-positive limit reads the 100 most recent messages.
+func (s *slack) GetChannelHistory(channel string, limit int) ([]HistoryMessage, error) {
+	if channel == "" {
+		return nil, &ErrInvalidChannel{Value: "channel is empty"}
+	}
+	channel, err := s.resolveChannel(channel)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	var messages []HistoryMessage
+	cursor := ""
+	for len(messages) < limit {
+		values := url.Values{}
+		values.Set("channel", channel)
+		values.Set("limit", strconv.Itoa(min(limit-len(messages), historyPageSize)))
+		if cursor != "" {
+			values.Set("cursor", cursor)
+		}
+		resp, err := s.postForm("conversations.history", headers, values)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		var response HistoryResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+		if !response.Ok {
+			return nil, responseError(response.SlackResponse, channel)
+		}
+		messages = append(messages, response.Messages...)
+		cursor = response.ResponseMetadata.Cursor
+		if cursor == "" {
+			break
+		}
+	}
+	if len(messages) > limit {
+		messages = messages[:limit]
+	}
+	return messages, nil
+}
+
+// OpenDM opens the direct message channel with a user, or returns the existing one, so that
+// messages can be sent to the user with SendText or AddFormattedMessage. The token needs the
+// im:write scope.
+func (s *slack) OpenDM(userID string) (string, error) {
+	if userID == "" {
+		return "", &ErrInvalidUser{Value: "user ID is empty"}
+	}
+	values := url.Values{}
+	values.Set("users", userID)
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	resp, err := s.postForm("conversations.open", headers, values)
+	if err != nil {
+		return "", err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	var response openConversationResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", err
+	}
+	if !response.Ok {
+		if response.Error == "user_not_found" {
+			return "", &ErrUserNotFound{Value: userID}
+		}
+		return "", responseError(response.SlackResponse, "")
+	}
+	return response.Channel.ID, nil
+}
+
+// listConversations returns every non-archived public and private channel from a
+// paginated conversations endpoint.
+func (s *slack) listConversations(endpoint string) ([]Conversation, error) {
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	var conversations []Conversation
+	cursor := ""
+	for {
+		values := url.Values{}
+		values.Set("types", "public_channel,private_channel")
+		values.Set("exclude_archived", "true")
+		values.Set("limit", "200")
+		if cursor != "" {
+			values.Set("cursor", cursor)
+		}
+		resp, err := s.postForm(endpoint, headers, values)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		var response ConversationsResponse
+		if err := json.Unmarshal(body, &response); err != nil {
+			return nil, err
+		}
+		if !response.Ok {
+			return nil, responseError(response.SlackResponse, "")
+		}
+		conversations = append(conversations, response.Channels...)
+		cursor = response.ResponseMetadata.Cursor
+		if cursor == "" {
+			return conversations, nil
+		}
+	}
+}
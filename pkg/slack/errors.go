@@ -13,11 +13,17 @@ func (e *ErrInvalidToken) Error() string {
 	return fmt.Sprintf("invalid token provided: %s", e.Value)
 }
 
+// ErrInvalidChannel represents a channel that is empty, unknown or unusable, Value is the channel
+// and Reason the Slack error, e.g. not_in_channel or is_archived, when Slack rejected it
 type ErrInvalidChannel struct {
-	Value string
+	Value  string
+	Reason string
 }
 
 func (e *ErrInvalidChannel) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("invalid channel provided: %s: %s", e.Value, e.Reason)
+	}
 	return fmt.Sprintf("invalid channel provided: %s", e.Value)
 }
 
@@ -29,6 +35,41 @@ func (e *ErrMessageNotFound) Error() string {
 	return fmt.Sprintf("message not found: %s", e.Value)
 }
 
+type ErrInvalidUser struct {
+	Value string
+}
+
+func (e *ErrInvalidUser) Error() string {
+	return fmt.Sprintf("invalid user provided: %s", e.Value)
+}
+
+// ErrUserNotFound represents a user lookup that matched no Slack user, Value is what was looked up
+type ErrUserNotFound struct {
+	Value string
+}
+
+func (e *ErrUserNotFound) Error() string {
+	return fmt.Sprintf("user not found: %s", e.Value)
+}
+
+// ErrAlreadyPinned represents a pin of a message that is already pinned, Value is its timestamp
+type ErrAlreadyPinned struct {
+	Value string
+}
+
+func (e *ErrAlreadyPinned) Error() string {
+	return fmt.Sprintf("message already pinned: %s", e.Value)
+}
+
+// ErrNotPinned represents an unpin of a message that is not pinned, Value is its timestamp
+type ErrNotPinned struct {
+	Value string
+}
+
+func (e *ErrNotPinned) Error() string {
+	return fmt.Sprintf("message not pinned: %s", e.Value)
+}
+
 type ErrFileUploadFailed struct {
 	Value string
 }
@@ -53,6 +94,16 @@ func (e *ErrUnauthorized) Error() string {
 	return fmt.Sprintf("unauthorized: invalid token %s", e.Value)
 }
 
+// ErrInvalidBlock represents a block that Slack would reject, Index is its position in the message
+type ErrInvalidBlock struct {
+	Index int
+	Value string
+}
+
+func (e *ErrInvalidBlock) Error() string {
+	return fmt.Sprintf("invalid block %d: %s", e.Index, e.Value)
+}
+
 // ErrRateLimit represents the rate limit response from slack
 type ErrRateLimit struct {
 	Value time.Duration
@@ -61,3 +112,12 @@ type ErrRateLimit struct {
 func (e *ErrRateLimit) Error() string {
 	return fmt.Sprintf("slack rate limit exceeded, retry after %s", e.Value)
 }
+
+// ErrSlackResponse represents an error returned by the slack web api
+type ErrSlackResponse struct {
+	Value string
+}
+
+func (e *ErrSlackResponse) Error() string {
+	return fmt.Sprintf("error slack response: %s", e.Value)
+}
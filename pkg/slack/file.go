@@ -2,11 +2,18 @@ package slack
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 )
 
+// defaultMaxUploadSize is the largest file Slack accepts, 1GB.
+const defaultMaxUploadSize = 1 << 30
+
+// UploadFileWithContent uploads a file with content, see UploadFileWithContentResult.
 func (s *slack) UploadFileWithContent(
 	fileType string,
 	fileName string,
@@ -14,6 +21,33 @@ func (s *slack) UploadFileWithContent(
 	content string,
 	messageRef MessageRef,
 ) error {
+	_, err := s.UploadFileWithContentResult(fileType, fileName, title, content, messageRef)
+	return err
+}
+
+// UploadFileWithContentResult uploads a file with content to the channel of messageRef, in the
+// thread of the message when messageRef has a timestamp, and returns the uploaded file.
+// Nothing is uploaded when content is empty, and a nil file is returned. Content larger than
+// Config.MaxUploadSize fails with ErrFileUploadFailed before anything is sent.
+// It uses Slack's legacy files.upload API, see UploadFile for sharing the file with a message.
+func (s *slack) UploadFileWithContentResult(
+	fileType string,
+	fileName string,
+	title string,
+	content string,
+	messageRef MessageRef,
+) (*UploadedFile, error) {
+	if content == "" {
+		return nil, nil
+	}
+	if err := s.checkUploadSize(fileName, len(content)); err != nil {
+		return nil, err
+	}
+	channel, err := s.resolveChannel(messageRef.Channel)
+	if err != nil {
+		return nil, err
+	}
+	messageRef.Channel = channel
 	values := url.Values{}
 	if fileType != "" {
 		values.Add("filetype", fileType)
@@ -33,25 +67,172 @@ func (s *slack) UploadFileWithContent(
 	headers := map[string]string{
 		"Content-Type": "application/x-www-form-urlencoded",
 	}
-	var response SlackResponse
-	if content != "" {
-		values.Add("content", content)
-		if resp, err := s.postForm("files.upload", headers, values); err != nil {
-			return err
-		} else {
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return err
-			}
-			if err := json.Unmarshal(body, &response); err != nil {
-				return err
-			}
-			if !response.Ok {
-				return &ErrFileUploadFailed{
-					Value: response.Error,
-				}
-			}
+	values.Add("content", content)
+	resp, err := s.postForm("files.upload", headers, values)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var response FileUploadResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	if !response.Ok {
+		return nil, &ErrFileUploadFailed{
+			Value: response.Error,
 		}
 	}
-	return nil
+	return &response.File, nil
+}
+
+// UploadFile uploads a file with Slack's external upload flow and shares it in the channel of
+// messageRef, in the thread of the message when messageRef has a timestamp, with the initial
+// comment and blocks of upload as the message introducing the file. The file is kept private
+// when messageRef has no channel. Nothing is uploaded when the content is empty, and a nil file
+// is returned. Content larger than Config.MaxUploadSize fails with ErrFileUploadFailed before
+// anything is sent.
+func (s *slack) UploadFile(upload FileUpload, messageRef MessageRef) (*UploadedFile, error) {
+	if upload.Content == "" {
+		return nil, nil
+	}
+	if upload.FileName == "" {
+		return nil, &ErrFileUploadFailed{Value: "file name is empty"}
+	}
+	if err := s.checkUploadSize(upload.FileName, len(upload.Content)); err != nil {
+		return nil, err
+	}
+	channel, err := s.resolveChannel(messageRef.Channel)
+	if err != nil {
+		return nil, err
+	}
+	messageRef.Channel = channel
+
+	target, err := s.getUploadURL(upload)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uploadContent(target.UploadURL, upload.Content); err != nil {
+		return nil, err
+	}
+	return s.completeUpload(target.FileID, upload, messageRef)
+}
+
+// getUploadURL requests the URL the content of upload is sent to, and the ID of the file.
+func (s *slack) getUploadURL(upload FileUpload) (*uploadURLResponse, error) {
+	values := url.Values{}
+	values.Set("filename", upload.FileName)
+	values.Set("length", strconv.Itoa(len(upload.Content)))
+	if upload.SnippetType != "" {
+		values.Set("snippet_type", upload.SnippetType)
+	}
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	resp, err := s.postForm("files.getUploadURLExternal", headers, values)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var response uploadURLResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	if !response.Ok {
+		return nil, &ErrFileUploadFailed{Value: response.Error}
+	}
+	return &response, nil
+}
+
+// uploadContent sends content to the upload URL in a single request with its Content-Length.
+// The URL is pre-signed, so the token is not sent.
+func (s *slack) uploadContent(uploadURL string, content string) error {
+	req, err := http.NewRequestWithContext(s.cfg.Context, http.MethodPost, uploadURL, strings.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := s.do("files.upload_url", req)
+	if err != nil {
+		return &ErrFileUploadFailed{Value: err.Error()}
+	}
+	return resp.Body.Close()
+}
+
+// completeUpload finishes the upload of the file with id and shares it in the channel of
+// messageRef with the initial comment and blocks of upload.
+func (s *slack) completeUpload(id string, upload FileUpload, messageRef MessageRef) (*UploadedFile, error) {
+	title := upload.Title
+	if title == "" {
+		title = upload.FileName
+	}
+	files, err := json.Marshal([]map[string]string{{"id": id, "title": title}})
+	if err != nil {
+		return nil, err
+	}
+	values := url.Values{}
+	values.Set("files", string(files))
+	if messageRef.Channel != "" {
+		values.Set("channel_id", messageRef.Channel)
+		if messageRef.Timestamp != "" {
+			values.Set("thread_ts", messageRef.Timestamp)
+		}
+	}
+	if upload.InitialComment != "" {
+		values.Set("initial_comment", upload.InitialComment)
+	}
+	if len(upload.Blocks) > 0 {
+		blocks, err := json.Marshal(upload.Blocks)
+		if err != nil {
+			return nil, err
+		}
+		values.Set("blocks", string(blocks))
+	}
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	resp, err := s.postForm("files.completeUploadExternal", headers, values)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var response completeUploadResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	if !response.Ok {
+		return nil, &ErrFileUploadFailed{Value: response.Error}
+	}
+	if len(response.Files) == 0 {
+		return &UploadedFile{ID: id, Name: upload.FileName, Title: title}, nil
+	}
+	return &response.Files[0], nil
+}
+
+// checkUploadSize rejects content of size bytes when it is larger than the upload limit, so that
+// the upload does not fail server-side after transferring the content. Content is never chunked:
+// files.upload takes it in a form encoded body and the external upload URL in a single request,
+// both built from a reader of known size, so net/http sends them with their Content-Length.
+func (s *slack) checkUploadSize(fileName string, size int) error {
+	maxSize := s.cfg.MaxUploadSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxUploadSize
+	}
+	if int64(size) <= maxSize {
+		return nil
+	}
+	if fileName == "" {
+		fileName = "content"
+	}
+	return &ErrFileUploadFailed{
+		Value: fmt.Sprintf("%s: %d bytes exceeds the upload limit of %d bytes", fileName, size, maxSize),
+	}
 }
@@ -1,5 +1,10 @@
 package slack
 
+import (
+	"encoding/json"
+	"net/url"
+)
+
 //go:generate mockgen -source=interface.go -destination=mocks/mock-slack.go -package=mocks
 
 type ISlack interface {
@@ -14,6 +19,27 @@ type ISlack interface {
 	//   - error: Any error that occurred during upload
 	UploadFileWithContent(fileType, fileName, title, content string, messageRef MessageRef) error
 
+	// UploadFileWithContentResult uploads a file like UploadFileWithContent and returns it.
+	// Parameters:
+	//   - fileType: The type of file (e.g., "text")
+	//   - fileName: Name of the file
+	//   - title: Title of the file
+	//   - content: Content of the file, nothing is uploaded when empty
+	//   - messageRef: Reference to a message if posting in a thread
+	// Returns:
+	//   - *UploadedFile: The ID, name, title and permalinks of the file, nil when content is empty
+	//   - error: Any error that occurred during upload
+	UploadFileWithContentResult(fileType, fileName, title, content string, messageRef MessageRef) (*UploadedFile, error)
+
+	// UploadFile uploads a file with the external upload flow and shares it with a message.
+	// Parameters:
+	//   - upload: The file name, title, content and the initial comment or blocks shared with it
+	//   - messageRef: The channel to share the file in, and a message if posting in a thread
+	// Returns:
+	//   - *UploadedFile: The ID, name, title and permalinks of the file, nil when content is empty
+	//   - error: Any error that occurred during upload
+	UploadFile(upload FileUpload, messageRef MessageRef) (*UploadedFile, error)
+
 	// AddFormattedMessage sends a formatted message to a Slack channel.
 	// Parameters:
 	//   - channel: The channel to send the message to
@@ -23,6 +49,108 @@ type ISlack interface {
 	//   - error: Any error that occurred while sending
 	AddFormattedMessage(channel string, message Message) (MessageRef, error)
 
+	// SendText sends a plain text message to a channel.
+	// Parameters:
+	//   - channel: The channel to send the message to
+	//   - text: The text of the message
+	// Returns:
+	//   - MessageRef: Reference to the sent message
+	//   - error: Any error that occurred while sending
+	SendText(channel string, text string) (MessageRef, error)
+
+	// PostEphemeral sends a message to a channel that is only visible to one user,
+	// e.g. to respond to a slash command.
+	// Parameters:
+	//   - channel: The channel to send the message to
+	//   - user: The ID of the user the message is visible to
+	//   - message: The message content and formatting
+	// Returns:
+	//   - error: ErrInvalidChannel or ErrUnauthorized mapped from the slack error, or any other error
+	PostEphemeral(channel string, user string, message Message) error
+
+	// ReplyInThread posts a message as a reply in the thread of an existing message.
+	// Parameters:
+	//   - ref: Reference to the parent message of the thread
+	//   - message: The message content and formatting
+	// Returns:
+	//   - MessageRef: Reference to the posted reply
+	//   - error: Any error that occurred while sending
+	ReplyInThread(ref MessageRef, message Message) (MessageRef, error)
+
+	// UpdateMessage replaces the text and blocks of an existing message.
+	// Parameters:
+	//   - ref: Reference to the message to update
+	//   - message: The new message content and formatting
+	// Returns:
+	//   - MessageRef: Reference to the updated message
+	//   - error: ErrMessageNotFound if the message does not exist, or any other error
+	UpdateMessage(ref MessageRef, message Message) (MessageRef, error)
+
+	// DeleteMessage deletes a message.
+	// Parameters:
+	//   - ref: Reference to the message to delete
+	// Returns:
+	//   - error: ErrMessageNotFound if the message does not exist, or any other error
+	DeleteMessage(ref MessageRef) error
+
+	// ListBotChannels lists the channels the bot is a member of, following pagination.
+	// Returns:
+	//   - []string: IDs of the public and private channels the bot belongs to
+	//   - error: Any error that occurred while listing the channels
+	ListBotChannels() ([]string, error)
+
+	// GetChannelIDByName returns the ID of a channel from its name, caching the mapping,
+	// and unknown names, for the lifetime of the client.
+	// Parameters:
+	//   - name: Name of the channel, with or without a leading "#"
+	// Returns:
+	//   - string: ID of the channel
+	//   - error: ErrInvalidChannel if no channel has that name, or any other error
+	GetChannelIDByName(name string) (string, error)
+
+	// GetChannelHistory reads the most recent messages of a channel, following pagination.
+	// Parameters:
+	//   - channel: The channel to read the messages of
+	//   - limit: Maximum number of messages to return, 100 when not positive
+	// Returns:
+	//   - []HistoryMessage: The messages, newest first
+	//   - error: ErrInvalidChannel if the channel is not found, or any other error
+	GetChannelHistory(channel string, limit int) ([]HistoryMessage, error)
+
+	// OpenDM opens the direct message channel with a user, e.g. to notify them directly.
+	// Parameters:
+	//   - userID: The ID of the user, e.g. from LookupUserByEmail
+	// Returns:
+	//   - string: The ID of the direct message channel, to send messages to
+	//   - error: ErrUserNotFound if the user does not exist, or any other error
+	OpenDM(userID string) (string, error)
+
+	// LookupUserByEmail finds the Slack user with an email address, e.g. to mention them.
+	// Parameters:
+	//   - email: The email address of the user
+	// Returns:
+	//   - *User: The ID, name, real name and email of the user
+	//   - error: ErrUserNotFound if no user has that email, or any other error
+	LookupUserByEmail(email string) (*User, error)
+
+	// AuthTest checks the configured token against Slack's auth.test API.
+	// Returns:
+	//   - *AuthInfo: The user, team and bot the token belongs to
+	//   - error: ErrUnauthorized if the token is invalid, or any other error
+	AuthTest() (*AuthInfo, error)
+
+	// CallMethod calls a Slack web API method that has no first-class support in the client,
+	// e.g. pins.add or pins.list.
+	// Parameters:
+	//   - method: Name of the API method, e.g. "pins.add"
+	//   - params: Arguments of the method, sent form encoded
+	// Returns:
+	//   - *SlackResponse: The ok, error and warning envelope of the response
+	//   - json.RawMessage: The whole JSON response, to unmarshal the method specific fields from
+	//   - error: ErrInvalidChannel, ErrUnauthorized or ErrSlackResponse mapped from the slack
+	//     error, along with the response, or any other error
+	CallMethod(method string, params url.Values) (*SlackResponse, json.RawMessage, error)
+
 	// AddReaction adds a reaction emoji to a message.
 	// Parameters:
 	//   - name: Name of the reaction emoji
@@ -38,4 +166,28 @@ type ISlack interface {
 	// Returns:
 	//   - error: Any error that occurred while removing the reaction
 	RemoveReaction(name string, item MessageRef) error
+
+	// GetReactions reads the reactions of a message, e.g. to count votes.
+	// Parameters:
+	//   - item: Reference to the message to read the reactions of
+	// Returns:
+	//   - []Reaction: The name, count and users of each reaction, empty when there are none
+	//   - error: ErrMessageNotFound if the message does not exist, or any other error
+	GetReactions(item MessageRef) ([]Reaction, error)
+
+	// PinMessage pins a message to its channel, e.g. an important announcement.
+	// Parameters:
+	//   - item: Reference to the message to pin
+	// Returns:
+	//   - error: ErrAlreadyPinned if the message is already pinned, ErrMessageNotFound if it does
+	//     not exist, or any other error
+	PinMessage(item MessageRef) error
+
+	// UnpinMessage unpins a message from its channel.
+	// Parameters:
+	//   - item: Reference to the message to unpin
+	// Returns:
+	//   - error: ErrNotPinned if the message is not pinned, ErrMessageNotFound if it does not
+	//     exist, or any other error
+	UnpinMessage(item MessageRef) error
 }
@@ -2,7 +2,10 @@ package slack
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -25,6 +28,100 @@ func checkStatusCode(resp *http.Response) error {
 	return nil
 }
 
+// do sends the request, retrying up to Config.MaxRetries times when slack responds
+// with a rate limit. Requests fail with ErrInvalidToken without hitting the network
+// when no token is configured. Between attempts it sleeps for the Retry-After duration unless
+// the request context is done or its deadline would expire first.
+func (s *slack) do(endpoint string, req *http.Request) (*http.Response, error) {
+	if s.cfg.Token == "" {
+		return nil, &ErrInvalidToken{Value: "token is empty"}
+	}
+	for attempt := 0; ; attempt++ {
+		resp, err := s.send(endpoint, req)
+		var rateLimit *ErrRateLimit
+		if !errors.As(err, &rateLimit) || attempt >= s.cfg.MaxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+		_ = resp.Body.Close()
+
+		ctx := req.Context()
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < rateLimit.Value {
+			return resp, err
+		}
+		timer := time.NewTimer(rateLimit.Value)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, err
+		case <-timer.C:
+		}
+
+		req = req.Clone(ctx)
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, bodyErr
+			}
+			req.Body = body
+		}
+		s.cfg.Logger.Debug("retrying rate limited slack request", "method", endpoint, "attempt", attempt+1)
+	}
+}
+
+// send sends the request once and logs the method, channel, latency and outcome of the call.
+func (s *slack) send(endpoint string, req *http.Request) (*http.Response, error) {
+	if s.cfg.Limiter != nil {
+		if err := s.cfg.Limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+	start := time.Now()
+	resp, err := s.httpClient.Do(req)
+	latency := time.Since(start)
+	if err != nil {
+		s.cfg.Logger.Error("slack request failed", "method", endpoint, "latency", latency, "error", err)
+		return resp, err
+	}
+	if err := checkStatusCode(resp); err != nil {
+		s.cfg.Logger.Error(
+			"slack request failed",
+			"method", endpoint,
+			"latency", latency,
+			"status", resp.StatusCode,
+			"error", err,
+		)
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		s.cfg.Logger.Error("slack request failed", "method", endpoint, "latency", latency, "error", err)
+		return resp, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var response SlackResponse
+	if err := json.Unmarshal(body, &response); err == nil && !response.Ok {
+		s.cfg.Logger.Error(
+			"slack request returned an error",
+			"method", endpoint,
+			"channel", response.Channel,
+			"latency", latency,
+			"ok", response.Ok,
+			"error", response.Error,
+		)
+		return resp, nil
+	}
+	s.cfg.Logger.Debug(
+		"slack request succeeded",
+		"method", endpoint,
+		"channel", response.Channel,
+		"latency", latency,
+		"ok", response.Ok,
+	)
+	return resp, nil
+}
+
 func (s *slack) postRequest(
 	endpoint string,
 	headers map[string]string,
@@ -37,20 +134,13 @@ func (s *slack) postRequest(
 		bytes.NewBuffer(reqBody),
 	)
 	if err != nil {
-		return resp, fmt.Errorf("error post to slack: %v", err)
+		return resp, fmt.Errorf("error post to slack: %w", err)
 	}
 	for key, value := range headers {
 		req.Header.Set(key, value)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.cfg.Token))
-	resp, err = s.httpClient.Do(req)
-	if err != nil {
-		return resp, err
-	}
-	if err := checkStatusCode(resp); err != nil {
-		return resp, err
-	}
-	return resp, nil
+	return s.do(endpoint, req)
 }
 
 func (s *slack) postForm(
@@ -67,14 +157,7 @@ func (s *slack) postForm(
 		req.Header.Set(key, value)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.cfg.Token))
-	resp, err = s.httpClient.Do(req)
-	if err != nil {
-		return resp, err
-	}
-	if err := checkStatusCode(resp); err != nil {
-		return resp, err
-	}
-	return resp, nil
+	return s.do(endpoint, req)
 }
 
 func (s *slack) getRequest(
@@ -82,8 +165,11 @@ func (s *slack) getRequest(
 	headers map[string]string,
 	values url.Values,
 ) (resp *http.Response, err error) {
-	reqBody := strings.NewReader(values.Encode())
-	req, err := http.NewRequestWithContext(s.cfg.Context, http.MethodGet, fmt.Sprintf("%s/%s", s.cfg.BaseURL, endpoint), reqBody)
+	u := fmt.Sprintf("%s/%s", s.cfg.BaseURL, endpoint)
+	if len(values) > 0 {
+		u += "?" + values.Encode()
+	}
+	req, err := http.NewRequestWithContext(s.cfg.Context, http.MethodGet, u, nil)
 	if err != nil {
 		return resp, err
 	}
@@ -91,12 +177,17 @@ func (s *slack) getRequest(
 		req.Header.Set(key, value)
 	}
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.cfg.Token))
-	resp, err = s.httpClient.Do(req)
-	if err != nil {
-		return resp, err
-	}
-	if err := checkStatusCode(resp); err != nil {
-		return resp, err
+	return s.do(endpoint, req)
+}
+
+// responseError maps the error string of a failed slack response to a typed error.
+func responseError(response SlackResponse, channel string) error {
+	switch response.Error {
+	case "channel_not_found", "not_in_channel", "is_archived":
+		return &ErrInvalidChannel{Value: channel, Reason: response.Error}
+	case "not_authed", "invalid_auth", "account_inactive", "token_revoked", "token_expired":
+		return &ErrUnauthorized{Value: response.Error}
+	default:
+		return &ErrSlackResponse{Value: response.Error}
 	}
-	return resp, nil
 }
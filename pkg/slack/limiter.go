@@ -0,0 +1,65 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter is a token bucket rate limiter that can be shared by several slack
+// clients, e.g. clients using different tokens of the same workspace, so that
+// together they stay within slack's rate limits.
+type Limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+// NewLimiter creates a Limiter that allows perMinute requests per minute with
+// bursts of up to burst requests. A burst lower than 1 is treated as 1.
+func NewLimiter(perMinute int, burst int) *Limiter {
+	if perMinute <= 0 {
+		panic("perMinute must be greater than zero")
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{
+		interval: time.Minute / time.Duration(perMinute),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed or ctx is done, in which case the
+// context error is returned and the reserved token is given back.
+func (l *Limiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += float64(now.Sub(l.last)) / float64(l.interval)
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	wait := time.Duration(-l.tokens * float64(l.interval))
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
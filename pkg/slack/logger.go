@@ -0,0 +1,15 @@
+package slack
+
+// Logger is the interface used to emit structured logs for every slack api call.
+// keysAndValues are alternating key/value pairs, e.g. "method", "chat.postMessage".
+type Logger interface {
+	Debug(msg string, keysAndValues ...any)
+	Error(msg string, keysAndValues ...any)
+}
+
+// noopLogger discards all log entries.
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...any) {}
+
+func (noopLogger) Error(string, ...any) {}
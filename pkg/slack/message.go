@@ -11,6 +11,16 @@ func (s *slack) AddFormattedMessage(
 	channel string,
 	message Message,
 ) (messageRef MessageRef, err error) {
+	if channel == "" {
+		return messageRef, &ErrInvalidChannel{Value: "channel is empty"}
+	}
+	channel, err = s.resolveChannel(channel)
+	if err != nil {
+		return messageRef, err
+	}
+	if err := ValidateBlocks(message.Blocks); err != nil {
+		return messageRef, err
+	}
 	message.Channel = channel
 	var response SlackResponse
 
@@ -24,7 +34,7 @@ func (s *slack) AddFormattedMessage(
 	}
 	resp, err := s.postRequest(apiEndpoint, header, reqBody)
 	if err != nil {
-		return messageRef, fmt.Errorf("error post to slack: %v", err)
+		return messageRef, fmt.Errorf("error post to slack: %w", err)
 	} else {
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
@@ -34,7 +44,7 @@ func (s *slack) AddFormattedMessage(
 			return messageRef, err
 		}
 		if !response.Ok {
-			return messageRef, fmt.Errorf("error slack response")
+			return messageRef, responseError(response, channel)
 		}
 		messageRef.Channel = response.Channel
 		messageRef.Timestamp = response.Ts
@@ -42,6 +52,59 @@ func (s *slack) AddFormattedMessage(
 	return messageRef, nil
 }
 
+// SendText posts a plain text message to channel.
+func (s *slack) SendText(channel string, text string) (MessageRef, error) {
+	return s.AddFormattedMessage(channel, Message{Text: text})
+}
+
+// PostEphemeral posts message to channel so that it is only visible to user.
+func (s *slack) PostEphemeral(channel string, user string, message Message) error {
+	if channel == "" {
+		return &ErrInvalidChannel{Value: "channel is empty"}
+	}
+	if user == "" {
+		return &ErrInvalidUser{Value: "user is empty"}
+	}
+	if err := ValidateBlocks(message.Blocks); err != nil {
+		return err
+	}
+	channel, err := s.resolveChannel(channel)
+	if err != nil {
+		return err
+	}
+	message.Channel = channel
+	var response SlackResponse
+
+	header := map[string]string{
+		"Content-Type": "application/json; charset=utf-8",
+	}
+	reqBody, err := json.Marshal(ephemeralMessage{Message: message, User: user})
+	if err != nil {
+		return err
+	}
+	resp, err := s.postRequest("chat.postEphemeral", header, reqBody)
+	if err != nil {
+		return fmt.Errorf("error post to slack: %w", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+	if !response.Ok {
+		return responseError(response, channel)
+	}
+	return nil
+}
+
+// ReplyInThread posts message as a reply in the thread of the message identified by ref.
+func (s *slack) ReplyInThread(ref MessageRef, message Message) (MessageRef, error) {
+	message.Thread = ref.Timestamp
+	return s.AddFormattedMessage(ref.Channel, message)
+}
+
 func (s *slack) AddScheduleMessage(
 	channel string,
 	message Message,
@@ -60,7 +123,7 @@ func (s *slack) AddScheduleMessage(
 	}
 	resp, err := s.postRequest(apiEndpoint, header, reqBody)
 	if err != nil {
-		return messageRef, fmt.Errorf("error post to slack: %v", err)
+		return messageRef, fmt.Errorf("error post to slack: %w", err)
 	} else {
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
@@ -93,7 +156,7 @@ func (m *slack) GetPermalink(channel string, messageRef MessageRef) (string, err
 	}
 	resp, err := m.getRequest(apiEndpoint, header, values)
 	if err != nil {
-		return "", fmt.Errorf("error post to slack: %v", err)
+		return "", fmt.Errorf("error post to slack: %w", err)
 	} else {
 		body, err := io.ReadAll(resp.Body)
 		if err != nil {
@@ -113,3 +176,98 @@ func (m *slack) GetPermalink(channel string, messageRef MessageRef) (string, err
 		return response.Permanent, nil
 	}
 }
+
+// UpdateMessage updates an existing message identified by ref.
+func (s *slack) UpdateMessage(ref MessageRef, message Message) (messageRef MessageRef, err error) {
+	if ref.Channel == "" {
+		return messageRef, &ErrInvalidChannel{Value: "channel is empty"}
+	}
+	if err := ValidateBlocks(message.Blocks); err != nil {
+		return messageRef, err
+	}
+	ref.Channel, err = s.resolveChannel(ref.Channel)
+	if err != nil {
+		return messageRef, err
+	}
+	var response SlackResponse
+
+	reqBody, err := json.Marshal(struct {
+		Message
+		Ts string `json:"ts"`
+	}{
+		Message: Message{
+			Channel:     ref.Channel,
+			Text:        message.Text,
+			Blocks:      message.Blocks,
+			Attachments: message.Attachments,
+		},
+		Ts: ref.Timestamp,
+	})
+	if err != nil {
+		return messageRef, err
+	}
+	header := map[string]string{
+		"Content-Type": "application/json; charset=utf-8",
+	}
+	resp, err := s.postRequest("chat.update", header, reqBody)
+	if err != nil {
+		return messageRef, fmt.Errorf("error post to slack: %w", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return messageRef, err
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return messageRef, err
+	}
+	if !response.Ok {
+		if response.Error == "message_not_found" {
+			return messageRef, &ErrMessageNotFound{Value: ref.Timestamp}
+		}
+		return messageRef, responseError(response, ref.Channel)
+	}
+	messageRef.Channel = response.Channel
+	messageRef.Timestamp = response.Ts
+	return messageRef, nil
+}
+
+// DeleteMessage deletes the message identified by ref.
+func (s *slack) DeleteMessage(ref MessageRef) (err error) {
+	if ref.Channel == "" {
+		return &ErrInvalidChannel{Value: "channel is empty"}
+	}
+	ref.Channel, err = s.resolveChannel(ref.Channel)
+	if err != nil {
+		return err
+	}
+	var response SlackResponse
+
+	reqBody, err := json.Marshal(map[string]string{
+		"channel": ref.Channel,
+		"ts":      ref.Timestamp,
+	})
+	if err != nil {
+		return err
+	}
+	header := map[string]string{
+		"Content-Type": "application/json; charset=utf-8",
+	}
+	resp, err := s.postRequest("chat.delete", header, reqBody)
+	if err != nil {
+		return fmt.Errorf("error post to slack: %w", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+	if !response.Ok {
+		if response.Error == "message_not_found" {
+			return &ErrMessageNotFound{Value: ref.Timestamp}
+		}
+		return responseError(response, ref.Channel)
+	}
+	return nil
+}
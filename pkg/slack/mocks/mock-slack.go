@@ -10,6 +10,8 @@
 package mocks
 
 import (
+	json "encoding/json"
+	url "net/url"
 	reflect "reflect"
 
 	slack "github.com/pal-paul/go-libraries/pkg/slack"
@@ -69,6 +71,169 @@ func (mr *MockISlackMockRecorder) AddReaction(name, item any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockISlack)(nil).AddReaction), name, item)
 }
 
+// AuthTest mocks base method.
+func (m *MockISlack) AuthTest() (*slack.AuthInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthTest")
+	ret0, _ := ret[0].(*slack.AuthInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthTest indicates an expected call of AuthTest.
+func (mr *MockISlackMockRecorder) AuthTest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthTest", reflect.TypeOf((*MockISlack)(nil).AuthTest))
+}
+
+// CallMethod mocks base method.
+func (m *MockISlack) CallMethod(method string, params url.Values) (*slack.SlackResponse, json.RawMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallMethod", method, params)
+	ret0, _ := ret[0].(*slack.SlackResponse)
+	ret1, _ := ret[1].(json.RawMessage)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CallMethod indicates an expected call of CallMethod.
+func (mr *MockISlackMockRecorder) CallMethod(method, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallMethod", reflect.TypeOf((*MockISlack)(nil).CallMethod), method, params)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockISlack) DeleteMessage(ref slack.MessageRef) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockISlackMockRecorder) DeleteMessage(ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockISlack)(nil).DeleteMessage), ref)
+}
+
+// GetChannelHistory mocks base method.
+func (m *MockISlack) GetChannelHistory(channel string, limit int) ([]slack.HistoryMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelHistory", channel, limit)
+	ret0, _ := ret[0].([]slack.HistoryMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelHistory indicates an expected call of GetChannelHistory.
+func (mr *MockISlackMockRecorder) GetChannelHistory(channel, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelHistory", reflect.TypeOf((*MockISlack)(nil).GetChannelHistory), channel, limit)
+}
+
+// GetChannelIDByName mocks base method.
+func (m *MockISlack) GetChannelIDByName(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelIDByName", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelIDByName indicates an expected call of GetChannelIDByName.
+func (mr *MockISlackMockRecorder) GetChannelIDByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelIDByName", reflect.TypeOf((*MockISlack)(nil).GetChannelIDByName), name)
+}
+
+// GetReactions mocks base method.
+func (m *MockISlack) GetReactions(item slack.MessageRef) ([]slack.Reaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactions", item)
+	ret0, _ := ret[0].([]slack.Reaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactions indicates an expected call of GetReactions.
+func (mr *MockISlackMockRecorder) GetReactions(item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactions", reflect.TypeOf((*MockISlack)(nil).GetReactions), item)
+}
+
+// ListBotChannels mocks base method.
+func (m *MockISlack) ListBotChannels() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBotChannels")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBotChannels indicates an expected call of ListBotChannels.
+func (mr *MockISlackMockRecorder) ListBotChannels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBotChannels", reflect.TypeOf((*MockISlack)(nil).ListBotChannels))
+}
+
+// LookupUserByEmail mocks base method.
+func (m *MockISlack) LookupUserByEmail(email string) (*slack.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupUserByEmail", email)
+	ret0, _ := ret[0].(*slack.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupUserByEmail indicates an expected call of LookupUserByEmail.
+func (mr *MockISlackMockRecorder) LookupUserByEmail(email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupUserByEmail", reflect.TypeOf((*MockISlack)(nil).LookupUserByEmail), email)
+}
+
+// OpenDM mocks base method.
+func (m *MockISlack) OpenDM(userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDM", userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenDM indicates an expected call of OpenDM.
+func (mr *MockISlackMockRecorder) OpenDM(userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDM", reflect.TypeOf((*MockISlack)(nil).OpenDM), userID)
+}
+
+// PinMessage mocks base method.
+func (m *MockISlack) PinMessage(item slack.MessageRef) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinMessage", item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinMessage indicates an expected call of PinMessage.
+func (mr *MockISlackMockRecorder) PinMessage(item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinMessage", reflect.TypeOf((*MockISlack)(nil).PinMessage), item)
+}
+
+// PostEphemeral mocks base method.
+func (m *MockISlack) PostEphemeral(channel, user string, message slack.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostEphemeral", channel, user, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostEphemeral indicates an expected call of PostEphemeral.
+func (mr *MockISlackMockRecorder) PostEphemeral(channel, user, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostEphemeral", reflect.TypeOf((*MockISlack)(nil).PostEphemeral), channel, user, message)
+}
+
 // RemoveReaction mocks base method.
 func (m *MockISlack) RemoveReaction(name string, item slack.MessageRef) error {
 	m.ctrl.T.Helper()
@@ -83,6 +248,80 @@ func (mr *MockISlackMockRecorder) RemoveReaction(name, item any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockISlack)(nil).RemoveReaction), name, item)
 }
 
+// ReplyInThread mocks base method.
+func (m *MockISlack) ReplyInThread(ref slack.MessageRef, message slack.Message) (slack.MessageRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyInThread", ref, message)
+	ret0, _ := ret[0].(slack.MessageRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplyInThread indicates an expected call of ReplyInThread.
+func (mr *MockISlackMockRecorder) ReplyInThread(ref, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyInThread", reflect.TypeOf((*MockISlack)(nil).ReplyInThread), ref, message)
+}
+
+// SendText mocks base method.
+func (m *MockISlack) SendText(channel, text string) (slack.MessageRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendText", channel, text)
+	ret0, _ := ret[0].(slack.MessageRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendText indicates an expected call of SendText.
+func (mr *MockISlackMockRecorder) SendText(channel, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendText", reflect.TypeOf((*MockISlack)(nil).SendText), channel, text)
+}
+
+// UnpinMessage mocks base method.
+func (m *MockISlack) UnpinMessage(item slack.MessageRef) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinMessage", item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinMessage indicates an expected call of UnpinMessage.
+func (mr *MockISlackMockRecorder) UnpinMessage(item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinMessage", reflect.TypeOf((*MockISlack)(nil).UnpinMessage), item)
+}
+
+// UpdateMessage mocks base method.
+func (m *MockISlack) UpdateMessage(ref slack.MessageRef, message slack.Message) (slack.MessageRef, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", ref, message)
+	ret0, _ := ret[0].(slack.MessageRef)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockISlackMockRecorder) UpdateMessage(ref, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockISlack)(nil).UpdateMessage), ref, message)
+}
+
+// UploadFile mocks base method.
+func (m *MockISlack) UploadFile(upload slack.FileUpload, messageRef slack.MessageRef) (*slack.UploadedFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadFile", upload, messageRef)
+	ret0, _ := ret[0].(*slack.UploadedFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadFile indicates an expected call of UploadFile.
+func (mr *MockISlackMockRecorder) UploadFile(upload, messageRef any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFile", reflect.TypeOf((*MockISlack)(nil).UploadFile), upload, messageRef)
+}
+
 // UploadFileWithContent mocks base method.
 func (m *MockISlack) UploadFileWithContent(fileType, fileName, title, content string, messageRef slack.MessageRef) error {
 	m.ctrl.T.Helper()
@@ -96,3 +335,18 @@ func (mr *MockISlackMockRecorder) UploadFileWithContent(fileType, fileName, titl
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFileWithContent", reflect.TypeOf((*MockISlack)(nil).UploadFileWithContent), fileType, fileName, title, content, messageRef)
 }
+
+// UploadFileWithContentResult mocks base method.
+func (m *MockISlack) UploadFileWithContentResult(fileType, fileName, title, content string, messageRef slack.MessageRef) (*slack.UploadedFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadFileWithContentResult", fileType, fileName, title, content, messageRef)
+	ret0, _ := ret[0].(*slack.UploadedFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadFileWithContentResult indicates an expected call of UploadFileWithContentResult.
+func (mr *MockISlackMockRecorder) UploadFileWithContentResult(fileType, fileName, title, content, messageRef any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFileWithContentResult", reflect.TypeOf((*MockISlack)(nil).UploadFileWithContentResult), fileType, fileName, title, content, messageRef)
+}
@@ -0,0 +1,60 @@
+package slack
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+)
+
+// PinMessage pins a message to its channel. Pinning a message that is already pinned fails
+// with ErrAlreadyPinned, which callers wanting an idempotent pin can ignore.
+func (s *slack) PinMessage(item MessageRef) error {
+	return s.pin("pins.add", item)
+}
+
+// UnpinMessage unpins a message from its channel. Unpinning a message that is not pinned fails
+// with ErrNotPinned, which callers wanting an idempotent unpin can ignore.
+func (s *slack) UnpinMessage(item MessageRef) error {
+	return s.pin("pins.remove", item)
+}
+
+// pin calls the pins endpoint, pins.add or pins.remove, for the message identified by item.
+func (s *slack) pin(endpoint string, item MessageRef) (err error) {
+	if item.Channel == "" {
+		return &ErrInvalidChannel{Value: "channel is empty"}
+	}
+	item.Channel, err = s.resolveChannel(item.Channel)
+	if err != nil {
+		return err
+	}
+	values := url.Values{}
+	values.Set("channel", item.Channel)
+	values.Set("timestamp", item.Timestamp)
+	headers := map[string]string{
+		"Content-Type": "application/x-www-form-urlencoded",
+	}
+	resp, err := s.postForm(endpoint, headers, values)
+	if err != nil {
+		return err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var response SlackResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+	if !response.Ok {
+		switch response.Error {
+		case "already_pinned":
+			return &ErrAlreadyPinned{Value: item.Timestamp}
+		case "no_pin":
+			return &ErrNotPinned{Value: item.Timestamp}
+		case "message_not_found":
+			return &ErrMessageNotFound{Value: item.Timestamp}
+		}
+		return responseError(response, item.Channel)
+	}
+	return nil
+}
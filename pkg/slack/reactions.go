@@ -9,6 +9,10 @@ import (
 
 // AddReaction adds a reaction emoji to a message
 func (api *slack) AddReaction(name string, item MessageRef) (err error) {
+	item.Channel, err = api.resolveChannel(item.Channel)
+	if err != nil {
+		return err
+	}
 	values := url.Values{}
 	if name != "" {
 		values.Set("name", name)
@@ -41,7 +45,11 @@ func (api *slack) AddReaction(name string, item MessageRef) (err error) {
 }
 
 // RemoveReactionContext removes a reaction emoji from a message.
-func (api *slack) RemoveReaction(name string, item MessageRef) error {
+func (api *slack) RemoveReaction(name string, item MessageRef) (err error) {
+	item.Channel, err = api.resolveChannel(item.Channel)
+	if err != nil {
+		return err
+	}
 	values := url.Values{}
 	if name != "" {
 		values.Set("name", name)
@@ -72,3 +80,37 @@ func (api *slack) RemoveReaction(name string, item MessageRef) error {
 	}
 	return nil
 }
+
+// GetReactions returns the reactions of a message, with every user who reacted.
+func (api *slack) GetReactions(item MessageRef) (reactions []Reaction, err error) {
+	if item.Channel == "" {
+		return nil, &ErrInvalidChannel{Value: "channel is empty"}
+	}
+	item.Channel, err = api.resolveChannel(item.Channel)
+	if err != nil {
+		return nil, err
+	}
+	values := url.Values{}
+	values.Set("channel", item.Channel)
+	values.Set("timestamp", item.Timestamp)
+	values.Set("full", "true")
+	resp, err := api.getRequest("reactions.get", nil, values)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var response ReactionsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	if !response.Ok {
+		if response.Error == "message_not_found" {
+			return nil, &ErrMessageNotFound{Value: item.Timestamp}
+		}
+		return nil, responseError(response.SlackResponse, item.Channel)
+	}
+	return response.Message.Reactions, nil
+}
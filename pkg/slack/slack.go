@@ -3,6 +3,7 @@ package slack
 //go:generate mockgen -source=interface.go -destination=mocks/mock-slack.go -package=mocks
 import (
 	"net/http"
+	"sync"
 )
 
 const (
@@ -12,6 +13,9 @@ const (
 type slack struct {
 	cfg        *Config
 	httpClient *http.Client
+
+	channelsMu sync.Mutex
+	channelIDs map[string]string // channelIDs caches channel IDs by name, "" for unknown names
 }
 
 // New creates a new Slack client with the provided options.
@@ -24,6 +28,15 @@ func New(opts ...Option) ISlack {
 	for _, opt := range opts {
 		opt(s.cfg)
 	}
+	if s.cfg.HTTPClient != nil {
+		s.httpClient = s.cfg.HTTPClient
+	}
+	if s.cfg.Timeout > 0 {
+		// copy the client so that a client injected with WithHTTPClient is not modified
+		client := *s.httpClient
+		client.Timeout = s.cfg.Timeout
+		s.httpClient = &client
+	}
 
 	return s
 }
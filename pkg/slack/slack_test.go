@@ -2,9 +2,15 @@ package slack_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pal-paul/go-libraries/pkg/slack"
 	"github.com/stretchr/testify/assert"
@@ -60,6 +66,7 @@ func TestAddFormattedMessage(t *testing.T) {
 		response  []byte
 		status    int
 		wantError bool
+		wantErr   error
 	}{
 		{
 			name:    "success",
@@ -94,6 +101,33 @@ func TestAddFormattedMessage(t *testing.T) {
 			status:    http.StatusBadRequest,
 			wantError: true,
 		},
+		{
+			name:      "channel not found",
+			channel:   "missing-channel",
+			message:   slack.Message{Text: "Test"},
+			response:  []byte(`{"ok": false, "error": "channel_not_found"}`),
+			status:    http.StatusOK,
+			wantError: true,
+			wantErr:   &slack.ErrInvalidChannel{Value: "missing-channel", Reason: "channel_not_found"},
+		},
+		{
+			name:      "invalid auth",
+			channel:   "test-channel",
+			message:   slack.Message{Text: "Test"},
+			response:  []byte(`{"ok": false, "error": "invalid_auth"}`),
+			status:    http.StatusOK,
+			wantError: true,
+			wantErr:   &slack.ErrUnauthorized{Value: "invalid_auth"},
+		},
+		{
+			name:      "other slack error",
+			channel:   "test-channel",
+			message:   slack.Message{Text: "Test"},
+			response:  []byte(`{"ok": false, "error": "msg_too_long"}`),
+			status:    http.StatusOK,
+			wantError: true,
+			wantErr:   &slack.ErrSlackResponse{Value: "msg_too_long"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -116,6 +150,9 @@ func TestAddFormattedMessage(t *testing.T) {
 			messageRef, err := client.AddFormattedMessage(tt.channel, tt.message)
 			if tt.wantError {
 				assert.Error(t, err)
+				if tt.wantErr != nil {
+					assert.Equal(t, tt.wantErr, err)
+				}
 				assert.Empty(t, messageRef)
 			} else {
 				assert.NoError(t, err)
@@ -199,3 +236,1386 @@ func TestUploadFileWithContent(t *testing.T) {
 		})
 	}
 }
+
+func TestUploadFileWithContentResult(t *testing.T) {
+	server := setupMockServer(t, "/api/files.upload", http.MethodPost, http.StatusOK, []byte(`{
+		"ok": true,
+		"file": {
+			"id": "F12345678",
+			"name": "report.csv",
+			"title": "Report",
+			"permalink": "https://example.slack.com/files/U1/F12345678/report.csv",
+			"permalink_public": "https://slack-files.com/T1-F12345678-abc"
+		}
+	}`))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	file, err := client.UploadFileWithContentResult("csv", "report.csv", "Report", "a,b\n1,2", slack.MessageRef{Channel: "C1"})
+	assert.NoError(t, err)
+	assert.Equal(t, &slack.UploadedFile{
+		ID:              "F12345678",
+		Name:            "report.csv",
+		Title:           "Report",
+		Permalink:       "https://example.slack.com/files/U1/F12345678/report.csv",
+		PermalinkPublic: "https://slack-files.com/T1-F12345678-abc",
+	}, file)
+
+	file, err = client.UploadFileWithContentResult("csv", "report.csv", "Report", "", slack.MessageRef{Channel: "C1"})
+	assert.NoError(t, err)
+	assert.Nil(t, file)
+}
+
+func TestUploadFile(t *testing.T) {
+	var calls []string
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/files.getUploadURLExternal":
+			assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "report.csv", r.PostForm.Get("filename"))
+			assert.Equal(t, "7", r.PostForm.Get("length"))
+			w.Write([]byte(fmt.Sprintf(`{"ok": true, "upload_url": %q, "file_id": "F12345678"}`, server.URL+"/upload/F12345678")))
+		case "/upload/F12345678":
+			assert.Empty(t, r.Header.Get("Authorization"), "the upload URL is pre-signed")
+			assert.Equal(t, int64(7), r.ContentLength)
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "a,b\n1,2", string(body))
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte("OK - 7"))
+		case "/api/files.completeUploadExternal":
+			assert.NoError(t, r.ParseForm())
+			assert.JSONEq(t, `[{"id": "F12345678", "title": "Report"}]`, r.PostForm.Get("files"))
+			assert.Equal(t, "C1", r.PostForm.Get("channel_id"))
+			assert.Equal(t, "1234567890.123456", r.PostForm.Get("thread_ts"))
+			assert.Equal(t, "*Nightly report* is ready", r.PostForm.Get("initial_comment"))
+			assert.JSONEq(t, `[{"type": "section", "text": {"type": "mrkdwn", "text": "*Nightly report*"}}]`, r.PostForm.Get("blocks"))
+			w.Write([]byte(`{"ok": true, "files": [{"id": "F12345678", "name": "report.csv", "title": "Report"}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	file, err := client.UploadFile(slack.FileUpload{
+		FileName:       "report.csv",
+		Title:          "Report",
+		Content:        "a,b\n1,2",
+		InitialComment: "*Nightly report* is ready",
+		Blocks: []slack.Block{{
+			Type: slack.SectionBlock,
+			Text: &slack.Text{Type: slack.Mrkdwn, Text: "*Nightly report*"},
+		}},
+	}, slack.MessageRef{Channel: "C1", Timestamp: "1234567890.123456"})
+	assert.NoError(t, err)
+	assert.Equal(t, &slack.UploadedFile{ID: "F12345678", Name: "report.csv", Title: "Report"}, file)
+	assert.Equal(t, []string{
+		"/api/files.getUploadURLExternal",
+		"/upload/F12345678",
+		"/api/files.completeUploadExternal",
+	}, calls)
+
+	// empty content uploads nothing, without resolving the channel name first
+	calls = nil
+	client = slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+		slack.WithAutoResolveChannels(),
+	)
+	file, err = client.UploadFile(slack.FileUpload{FileName: "report.csv"}, slack.MessageRef{Channel: "#reports"})
+	assert.NoError(t, err)
+	assert.Nil(t, file)
+	assert.Empty(t, calls)
+
+	_, err = client.UploadFile(slack.FileUpload{Content: "a,b"}, slack.MessageRef{Channel: "C1"})
+	var uploadErr *slack.ErrFileUploadFailed
+	assert.ErrorAs(t, err, &uploadErr)
+	assert.Empty(t, calls)
+}
+
+func TestUploadFileError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/files.getUploadURLExternal", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok": false, "error": "invalid_arguments"}`))
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	file, err := client.UploadFile(slack.FileUpload{FileName: "report.csv", Content: "a,b"}, slack.MessageRef{Channel: "C1"})
+	assert.Nil(t, file)
+	assert.Equal(t, &slack.ErrFileUploadFailed{Value: "invalid_arguments"}, err)
+}
+
+func TestUploadFileSize(t *testing.T) {
+	var contentLength int64
+	var bodyLength int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		contentLength = r.ContentLength
+		bodyLength = len(body)
+		w.Write([]byte(`{"ok": true, "file": {"id": "F12345678"}}`))
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+		slack.WithMaxUploadSize(10),
+	)
+
+	t.Run("within the limit", func(t *testing.T) {
+		err := client.UploadFileWithContent("text", "test.txt", "Test File", "0123456789", slack.MessageRef{Channel: "C1"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(bodyLength), contentLength)
+	})
+
+	t.Run("over the limit", func(t *testing.T) {
+		bodyLength = 0
+		err := client.UploadFileWithContent("text", "test.txt", "Test File", "0123456789a", slack.MessageRef{Channel: "C1"})
+		var uploadErr *slack.ErrFileUploadFailed
+		assert.ErrorAs(t, err, &uploadErr)
+		assert.Equal(t, "file upload failed for: test.txt: 11 bytes exceeds the upload limit of 10 bytes", err.Error())
+		assert.Zero(t, bodyLength, "nothing is sent")
+	})
+
+	t.Run("over the limit with the external flow", func(t *testing.T) {
+		bodyLength = 0
+		_, err := client.UploadFile(slack.FileUpload{FileName: "test.txt", Content: "0123456789a"}, slack.MessageRef{Channel: "C1"})
+		var uploadErr *slack.ErrFileUploadFailed
+		assert.ErrorAs(t, err, &uploadErr)
+		assert.Zero(t, bodyLength, "nothing is sent")
+	})
+}
+
+func TestUpdateMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		ref       slack.MessageRef
+		message   slack.Message
+		response  []byte
+		status    int
+		wantError bool
+		notFound  bool
+	}{
+		{
+			name: "success",
+			ref: slack.MessageRef{
+				Channel:   "test-channel",
+				Timestamp: "1234567890.123456",
+			},
+			message: slack.Message{Text: "Updated"},
+			response: []byte(`{
+				"ok": true,
+				"channel": "test-channel",
+				"ts": "1234567890.123456"
+			}`),
+			status:    http.StatusOK,
+			wantError: false,
+		},
+		{
+			name: "message not found",
+			ref: slack.MessageRef{
+				Channel:   "test-channel",
+				Timestamp: "1234567890.000000",
+			},
+			message:   slack.Message{Text: "Updated"},
+			response:  []byte(`{"ok": false, "error": "message_not_found"}`),
+			status:    http.StatusOK,
+			wantError: true,
+			notFound:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupMockServer(
+				t,
+				"/api/chat.update",
+				http.MethodPost,
+				tt.status,
+				tt.response,
+			)
+			defer server.Close()
+
+			client := slack.New(
+				slack.WithToken("test-token"),
+				slack.WithContext(context.Background()),
+				slack.WithBaseURL(server.URL+"/api"),
+			)
+
+			messageRef, err := client.UpdateMessage(tt.ref, tt.message)
+			if tt.wantError {
+				assert.Error(t, err)
+				if tt.notFound {
+					var notFound *slack.ErrMessageNotFound
+					assert.ErrorAs(t, err, &notFound)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ref, messageRef)
+			}
+		})
+	}
+}
+
+func TestDeleteMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		ref       slack.MessageRef
+		response  []byte
+		status    int
+		wantError bool
+	}{
+		{
+			name: "success",
+			ref: slack.MessageRef{
+				Channel:   "test-channel",
+				Timestamp: "1234567890.123456",
+			},
+			response:  []byte(`{"ok": true, "channel": "test-channel", "ts": "1234567890.123456"}`),
+			status:    http.StatusOK,
+			wantError: false,
+		},
+		{
+			name: "message not found",
+			ref: slack.MessageRef{
+				Channel:   "test-channel",
+				Timestamp: "1234567890.000000",
+			},
+			response:  []byte(`{"ok": false, "error": "message_not_found"}`),
+			status:    http.StatusOK,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupMockServer(
+				t,
+				"/api/chat.delete",
+				http.MethodPost,
+				tt.status,
+				tt.response,
+			)
+			defer server.Close()
+
+			client := slack.New(
+				slack.WithToken("test-token"),
+				slack.WithContext(context.Background()),
+				slack.WithBaseURL(server.URL+"/api"),
+			)
+
+			err := client.DeleteMessage(tt.ref)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type recordingLogger struct {
+	debug []string
+	error []string
+}
+
+func (l *recordingLogger) Debug(msg string, _ ...any) {
+	l.debug = append(l.debug, msg)
+}
+
+func (l *recordingLogger) Error(msg string, _ ...any) {
+	l.error = append(l.error, msg)
+}
+
+func TestWithLogger(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  []byte
+		status    int
+		wantDebug int
+		wantError int
+	}{
+		{
+			name:      "success is logged as debug",
+			response:  []byte(`{"ok": true, "channel": "test-channel", "ts": "1234567890.123456"}`),
+			status:    http.StatusOK,
+			wantDebug: 1,
+		},
+		{
+			name:      "slack error is logged as error",
+			response:  []byte(`{"ok": false, "error": "channel_not_found"}`),
+			status:    http.StatusOK,
+			wantError: 1,
+		},
+		{
+			name:      "status error is logged as error",
+			status:    http.StatusInternalServerError,
+			wantError: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupMockServer(
+				t,
+				"/api/chat.postMessage",
+				http.MethodPost,
+				tt.status,
+				tt.response,
+			)
+			defer server.Close()
+
+			logger := &recordingLogger{}
+			client := slack.New(
+				slack.WithToken("test-token"),
+				slack.WithBaseURL(server.URL+"/api"),
+				slack.WithLogger(logger),
+			)
+
+			_, _ = client.AddFormattedMessage("test-channel", slack.Message{Text: "Test"})
+			assert.Len(t, logger.debug, tt.wantDebug)
+			assert.Len(t, logger.error, tt.wantError)
+		})
+	}
+}
+
+func TestWithMaxRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxRetries int
+		wantCalls  int
+		wantError  bool
+	}{
+		{
+			name:       "retries after rate limit",
+			maxRetries: 1,
+			wantCalls:  2,
+			wantError:  false,
+		},
+		{
+			name:       "no retries by default",
+			maxRetries: 0,
+			wantCalls:  1,
+			wantError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				w.Header().Set("Content-Type", "application/json")
+				if calls == 1 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"ok": true, "channel": "test-channel", "ts": "1234567890.123456"}`))
+			}))
+			defer server.Close()
+
+			client := slack.New(
+				slack.WithToken("test-token"),
+				slack.WithBaseURL(server.URL+"/api"),
+				slack.WithMaxRetries(tt.maxRetries),
+			)
+
+			messageRef, err := client.AddFormattedMessage("test-channel", slack.Message{Text: "Test"})
+			assert.Equal(t, tt.wantCalls, calls)
+			if tt.wantError {
+				var rateLimit *slack.ErrRateLimit
+				assert.ErrorAs(t, err, &rateLimit)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "1234567890.123456", messageRef.Timestamp)
+			}
+		})
+	}
+}
+
+func TestWithMaxRetriesGet(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "jane@example.com", r.URL.Query().Get("email"))
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"ok": true, "user": {"id": "U12345678", "profile": {"email": "jane@example.com"}}}`))
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+		slack.WithMaxRetries(1),
+	)
+
+	user, err := client.LookupUserByEmail("jane@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, "U12345678", user.ID)
+}
+
+func TestSendText(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/chat.postMessage", r.URL.Path)
+
+		var message slack.Message
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		assert.Equal(t, slack.Message{Channel: "test-channel", Text: "Hello"}, message)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"ok": true, "channel": "test-channel", "ts": "1234567890.123456"}`))
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	ref, err := client.SendText("test-channel", "Hello")
+	assert.NoError(t, err)
+	assert.Equal(t, slack.MessageRef{Channel: "test-channel", Timestamp: "1234567890.123456"}, ref)
+}
+
+func TestPostEphemeral(t *testing.T) {
+	tests := []struct {
+		name      string
+		channel   string
+		user      string
+		response  []byte
+		wantError error
+	}{
+		{
+			name:     "success",
+			channel:  "test-channel",
+			user:     "U123",
+			response: []byte(`{"ok": true, "message_ts": "1234567890.123456"}`),
+		},
+		{
+			name:      "channel not found",
+			channel:   "test-channel",
+			user:      "U123",
+			response:  []byte(`{"ok": false, "error": "channel_not_found"}`),
+			wantError: &slack.ErrInvalidChannel{},
+		},
+		{
+			name:      "invalid auth",
+			channel:   "test-channel",
+			user:      "U123",
+			response:  []byte(`{"ok": false, "error": "invalid_auth"}`),
+			wantError: &slack.ErrUnauthorized{},
+		},
+		{
+			name:      "empty user",
+			channel:   "test-channel",
+			wantError: &slack.ErrInvalidUser{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/chat.postEphemeral", r.URL.Path)
+
+				var reqBody map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+				assert.Equal(t, tt.channel, reqBody["channel"])
+				assert.Equal(t, tt.user, reqBody["user"])
+				assert.Equal(t, "Only you can see this", reqBody["text"])
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := slack.New(
+				slack.WithToken("test-token"),
+				slack.WithBaseURL(server.URL+"/api"),
+			)
+
+			err := client.PostEphemeral(tt.channel, tt.user, slack.Message{Text: "Only you can see this"})
+			if tt.wantError != nil {
+				assert.IsType(t, tt.wantError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReplyInThread(t *testing.T) {
+	ref := slack.MessageRef{
+		Channel:   "test-channel",
+		Timestamp: "1234567890.123456",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/chat.postMessage", r.URL.Path)
+
+		var message slack.Message
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		assert.Equal(t, ref.Channel, message.Channel)
+		assert.Equal(t, ref.Timestamp, message.Thread)
+		assert.True(t, message.ReplyBroadcast)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"ok": true, "channel": "test-channel", "ts": "1234567890.654321"}`))
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	reply, err := client.ReplyInThread(ref, slack.Message{Text: "Reply", ReplyBroadcast: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "1234567890.654321", reply.Timestamp)
+}
+
+func TestWithSharedLimiter(t *testing.T) {
+	server := setupMockServer(
+		t,
+		"/api/chat.postMessage",
+		http.MethodPost,
+		http.StatusOK,
+		[]byte(`{"ok": true, "channel": "test-channel", "ts": "1234567890.123456"}`),
+	)
+	defer server.Close()
+
+	// one request every 50ms without bursts, shared by both clients
+	limiter := slack.NewLimiter(1200, 1)
+	clients := []slack.ISlack{
+		slack.New(slack.WithToken("test-token"), slack.WithBaseURL(server.URL+"/api"), slack.WithSharedLimiter(limiter)),
+		slack.New(slack.WithToken("test-token"), slack.WithBaseURL(server.URL+"/api"), slack.WithSharedLimiter(limiter)),
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		for _, client := range clients {
+			_, err := client.AddFormattedMessage("test-channel", slack.Message{Text: "Test"})
+			assert.NoError(t, err)
+		}
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 250*time.Millisecond)
+}
+
+func TestLimiterWaitCancelled(t *testing.T) {
+	limiter := slack.NewLimiter(1, 1)
+	assert.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+}
+
+func TestValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+	}))
+	defer server.Close()
+
+	t.Run("empty token", func(t *testing.T) {
+		client := slack.New(slack.WithBaseURL(server.URL + "/api"))
+
+		_, err := client.AddFormattedMessage("test-channel", slack.Message{Text: "Test"})
+		var invalidToken *slack.ErrInvalidToken
+		assert.ErrorAs(t, err, &invalidToken)
+	})
+
+	t.Run("empty channel", func(t *testing.T) {
+		client := slack.New(slack.WithToken("test-token"), slack.WithBaseURL(server.URL+"/api"))
+
+		_, err := client.AddFormattedMessage("", slack.Message{Text: "Test"})
+		var invalidChannel *slack.ErrInvalidChannel
+		assert.ErrorAs(t, err, &invalidChannel)
+	})
+
+	t.Run("invalid block", func(t *testing.T) {
+		client := slack.New(slack.WithToken("test-token"), slack.WithBaseURL(server.URL+"/api"))
+
+		_, err := client.AddFormattedMessage("C12345678", slack.Message{
+			Blocks: []slack.Block{{Type: slack.DividerBlock}, {Type: slack.SectionBlock}},
+		})
+		var invalidBlock *slack.ErrInvalidBlock
+		assert.ErrorAs(t, err, &invalidBlock)
+		assert.Equal(t, 1, invalidBlock.Index)
+	})
+}
+
+func TestValidateBlocks(t *testing.T) {
+	tests := []struct {
+		name      string
+		blocks    []slack.Block
+		wantError string
+	}{
+		{
+			name: "valid blocks",
+			blocks: []slack.Block{
+				{Type: slack.HeaderBlock, Text: &slack.Text{Type: slack.PlainText, Text: "Deploy"}},
+				{Type: slack.SectionBlock, Text: &slack.Text{Type: slack.Mrkdwn, Text: "*done*"}},
+				{Type: slack.SectionBlock, Fields: []slack.Field{{Type: slack.Mrkdwn, Text: "*Env*"}}},
+				{Type: slack.DividerBlock},
+				{Type: slack.ImageBlock, ImageURL: "https://example.com/a.png", AltText: "status"},
+				{Type: slack.ActionsBlock, Elements: []slack.Element{{Type: "button"}}},
+				{Type: "context", Elements: []slack.Element{}},
+			},
+		},
+		{
+			name:      "no blocks",
+			blocks:    nil,
+			wantError: "",
+		},
+		{
+			name:      "section without text or fields",
+			blocks:    []slack.Block{{Type: slack.DividerBlock}, {Type: slack.SectionBlock}},
+			wantError: "invalid block 1: section block has neither text nor fields",
+		},
+		{
+			name: "section text too long",
+			blocks: []slack.Block{
+				{Type: slack.SectionBlock, Text: &slack.Text{Type: slack.Mrkdwn, Text: strings.Repeat("a", 3001)}},
+			},
+			wantError: "invalid block 0: section block text exceeds 3000 characters",
+		},
+		{
+			name: "header with mrkdwn text",
+			blocks: []slack.Block{
+				{Type: slack.HeaderBlock, Text: &slack.Text{Type: slack.Mrkdwn, Text: "*Deploy*"}},
+			},
+			wantError: "invalid block 0: header block text must be plain_text",
+		},
+		{
+			name:      "image without alt text",
+			blocks:    []slack.Block{{Type: slack.ImageBlock, ImageURL: "https://example.com/a.png"}},
+			wantError: "invalid block 0: image block has no alt_text",
+		},
+		{
+			name:      "actions without elements",
+			blocks:    []slack.Block{{Type: slack.ActionsBlock}},
+			wantError: "invalid block 0: actions block has no elements",
+		},
+		{
+			name:      "too many blocks",
+			blocks:    make([]slack.Block, 51),
+			wantError: "invalid block 50: a message has at most 50 blocks, got 51",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := slack.ValidateBlocks(tt.blocks)
+			if tt.wantError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantError)
+		})
+	}
+}
+
+func TestMessageBuilder(t *testing.T) {
+	message := slack.NewMessageBuilder().
+		Text("Deploy finished").
+		Thread("1234567890.123456").
+		Broadcast().
+		Header("Deploy").
+		Section("*api* is live").
+		Fields("*Version*", "v1.4.2").
+		Divider().
+		Image("https://example.com/status.png", "deploy status").
+		Actions(slack.NewButton("Rollback", "rollback", "api", "danger")).
+		Build()
+
+	assert.Equal(t, slack.Message{
+		Text:           "Deploy finished",
+		Thread:         "1234567890.123456",
+		ReplyBroadcast: true,
+		Blocks: []slack.Block{
+			{Type: slack.HeaderBlock, Text: &slack.Text{Type: slack.PlainText, Text: "Deploy", Emoji: true}},
+			{Type: slack.SectionBlock, Text: &slack.Text{Type: slack.Mrkdwn, Text: "*api* is live"}},
+			{Type: slack.SectionBlock, Fields: []slack.Field{
+				{Type: slack.Mrkdwn, Text: "*Version*"},
+				{Type: slack.Mrkdwn, Text: "v1.4.2"},
+			}},
+			{Type: slack.DividerBlock},
+			{Type: slack.ImageBlock, ImageURL: "https://example.com/status.png", AltText: "deploy status"},
+			{Type: slack.ActionsBlock, Elements: []slack.Element{{
+				Type:     "button",
+				Text:     &slack.Text{Type: slack.PlainText, Text: "Rollback", Emoji: true},
+				ActionId: "rollback",
+				Value:    "api",
+				Style:    "danger",
+			}}},
+		},
+	}, message)
+	assert.NoError(t, slack.ValidateBlocks(message.Blocks))
+}
+
+func TestCallMethod(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  []byte
+		wantError error
+	}{
+		{
+			name:     "success",
+			response: []byte(`{"ok": true, "message": {"reactions": [{"name": "thumbsup", "count": 2}]}}`),
+		},
+		{
+			name:      "channel not found",
+			response:  []byte(`{"ok": false, "error": "channel_not_found"}`),
+			wantError: &slack.ErrInvalidChannel{Value: "C12345678", Reason: "channel_not_found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+				assert.Equal(t, "/api/reactions.get", r.URL.Path)
+				assert.NoError(t, r.ParseForm())
+				assert.Equal(t, "C12345678", r.PostForm.Get("channel"))
+				assert.Equal(t, "1234567890.123456", r.PostForm.Get("timestamp"))
+				w.Header().Set("Content-Type", "application/json")
+				w.Write(tt.response)
+			}))
+			defer server.Close()
+
+			client := slack.New(
+				slack.WithToken("test-token"),
+				slack.WithBaseURL(server.URL+"/api"),
+			)
+
+			response, raw, err := client.CallMethod("reactions.get", url.Values{
+				"channel":   {"C12345678"},
+				"timestamp": {"1234567890.123456"},
+			})
+			assert.Equal(t, tt.wantError, err)
+			assert.NotNil(t, response)
+			assert.JSONEq(t, string(tt.response), string(raw))
+			assert.Equal(t, tt.wantError == nil, response.Ok)
+		})
+	}
+}
+
+func TestAuthTest(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  []byte
+		want      *slack.AuthInfo
+		wantError error
+	}{
+		{
+			name: "success",
+			response: []byte(`{
+				"ok": true,
+				"url": "https://test.slack.com/",
+				"team": "Test Team",
+				"user": "bot",
+				"team_id": "T12345678",
+				"user_id": "U12345678",
+				"bot_id": "B12345678"
+			}`),
+			want: &slack.AuthInfo{
+				URL:    "https://test.slack.com/",
+				Team:   "Test Team",
+				User:   "bot",
+				TeamID: "T12345678",
+				UserID: "U12345678",
+				BotID:  "B12345678",
+			},
+		},
+		{
+			name:      "invalid auth",
+			response:  []byte(`{"ok": false, "error": "invalid_auth"}`),
+			wantError: &slack.ErrUnauthorized{Value: "invalid_auth"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := setupMockServer(
+				t,
+				"/api/auth.test",
+				http.MethodPost,
+				http.StatusOK,
+				tt.response,
+			)
+			defer server.Close()
+
+			client := slack.New(
+				slack.WithToken("test-token"),
+				slack.WithBaseURL(server.URL+"/api"),
+			)
+
+			info, err := client.AuthTest()
+			if tt.wantError != nil {
+				assert.Equal(t, tt.wantError, err)
+				assert.Nil(t, info)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, info)
+			}
+		})
+	}
+}
+
+func TestLookupUserByEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/users.lookupByEmail", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("email") {
+		case "jane@example.com":
+			w.Write([]byte(`{
+				"ok": true,
+				"user": {
+					"id": "U12345678",
+					"name": "jane",
+					"real_name": "Jane Doe",
+					"profile": {"email": "jane@example.com", "display_name": "jane"}
+				}
+			}`))
+		case "revoked@example.com":
+			w.Write([]byte(`{"ok": false, "error": "invalid_auth"}`))
+		default:
+			w.Write([]byte(`{"ok": false, "error": "users_not_found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	user, err := client.LookupUserByEmail("jane@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, &slack.User{
+		ID:       "U12345678",
+		Name:     "jane",
+		RealName: "Jane Doe",
+		Email:    "jane@example.com",
+	}, user)
+
+	user, err = client.LookupUserByEmail("nobody@example.com")
+	assert.Nil(t, user)
+	assert.Equal(t, &slack.ErrUserNotFound{Value: "nobody@example.com"}, err)
+
+	_, err = client.LookupUserByEmail("revoked@example.com")
+	var unauthorized *slack.ErrUnauthorized
+	assert.ErrorAs(t, err, &unauthorized)
+
+	_, err = client.LookupUserByEmail("")
+	var invalid *slack.ErrInvalidUser
+	assert.ErrorAs(t, err, &invalid)
+}
+
+func TestOpenDM(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/conversations.open", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, r.ParseForm())
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.PostForm.Get("users") {
+		case "U12345678":
+			w.Write([]byte(`{"ok": true, "channel": {"id": "D12345678"}}`))
+		case "U00000000":
+			w.Write([]byte(`{"ok": false, "error": "user_not_found"}`))
+		default:
+			w.Write([]byte(`{"ok": false, "error": "missing_scope"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	channel, err := client.OpenDM("U12345678")
+	assert.NoError(t, err)
+	assert.Equal(t, "D12345678", channel)
+
+	_, err = client.OpenDM("U00000000")
+	assert.Equal(t, &slack.ErrUserNotFound{Value: "U00000000"}, err)
+
+	_, err = client.OpenDM("U99999999")
+	assert.Equal(t, &slack.ErrSlackResponse{Value: "missing_scope"}, err)
+
+	_, err = client.OpenDM("")
+	var invalidUser *slack.ErrInvalidUser
+	assert.ErrorAs(t, err, &invalidUser)
+}
+
+func TestGetReactions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/reactions.get", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "C12345678", r.URL.Query().Get("channel"))
+		assert.Equal(t, "true", r.URL.Query().Get("full"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("timestamp") {
+		case "1234567890.123456":
+			w.Write([]byte(`{
+				"ok": true,
+				"type": "message",
+				"channel": "C12345678",
+				"message": {
+					"type": "message",
+					"text": "Ship it?",
+					"ts": "1234567890.123456",
+					"reactions": [
+						{"name": "thumbsup", "count": 2, "users": ["U1", "U2"]},
+						{"name": "thumbsdown", "count": 1, "users": ["U3"]}
+					]
+				}
+			}`))
+		case "1234567890.000001":
+			w.Write([]byte(`{"ok": true, "type": "message", "message": {"type": "message", "text": "No votes"}}`))
+		default:
+			w.Write([]byte(`{"ok": false, "error": "message_not_found"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	reactions, err := client.GetReactions(slack.MessageRef{Channel: "C12345678", Timestamp: "1234567890.123456"})
+	assert.NoError(t, err)
+	assert.Equal(t, []slack.Reaction{
+		{Name: "thumbsup", Count: 2, Users: []string{"U1", "U2"}},
+		{Name: "thumbsdown", Count: 1, Users: []string{"U3"}},
+	}, reactions)
+
+	reactions, err = client.GetReactions(slack.MessageRef{Channel: "C12345678", Timestamp: "1234567890.000001"})
+	assert.NoError(t, err)
+	assert.Empty(t, reactions)
+
+	_, err = client.GetReactions(slack.MessageRef{Channel: "C12345678", Timestamp: "1234567890.999999"})
+	assert.Equal(t, &slack.ErrMessageNotFound{Value: "1234567890.999999"}, err)
+
+	_, err = client.GetReactions(slack.MessageRef{Timestamp: "1234567890.123456"})
+	var channelErr *slack.ErrInvalidChannel
+	assert.ErrorAs(t, err, &channelErr)
+}
+
+func TestPinMessage(t *testing.T) {
+	pinned := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "C12345678", r.PostForm.Get("channel"))
+		ts := r.PostForm.Get("timestamp")
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case ts == "1234567890.999999":
+			w.Write([]byte(`{"ok": false, "error": "message_not_found"}`))
+		case r.URL.Path == "/api/pins.add" && pinned[ts]:
+			w.Write([]byte(`{"ok": false, "error": "already_pinned"}`))
+		case r.URL.Path == "/api/pins.add":
+			pinned[ts] = true
+			w.Write([]byte(`{"ok": true}`))
+		case r.URL.Path == "/api/pins.remove" && !pinned[ts]:
+			w.Write([]byte(`{"ok": false, "error": "no_pin"}`))
+		case r.URL.Path == "/api/pins.remove":
+			delete(pinned, ts)
+			w.Write([]byte(`{"ok": true}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+	ref := slack.MessageRef{Channel: "C12345678", Timestamp: "1234567890.123456"}
+
+	assert.NoError(t, client.PinMessage(ref))
+	assert.Equal(t, &slack.ErrAlreadyPinned{Value: ref.Timestamp}, client.PinMessage(ref))
+	assert.NoError(t, client.UnpinMessage(ref))
+	assert.Equal(t, &slack.ErrNotPinned{Value: ref.Timestamp}, client.UnpinMessage(ref))
+
+	missing := slack.MessageRef{Channel: "C12345678", Timestamp: "1234567890.999999"}
+	assert.Equal(t, &slack.ErrMessageNotFound{Value: missing.Timestamp}, client.PinMessage(missing))
+
+	var channelErr *slack.ErrInvalidChannel
+	assert.ErrorAs(t, client.UnpinMessage(slack.MessageRef{Timestamp: ref.Timestamp}), &channelErr)
+}
+
+func TestImageAndDividerBlocks(t *testing.T) {
+	message := slack.Message{
+		Blocks: []slack.Block{
+			{
+				Type:     slack.ImageBlock,
+				ImageURL: "https://example.com/status.png",
+				AltText:  "deploy status",
+				Title: &slack.Text{
+					Type: slack.PlainText,
+					Text: "Status",
+				},
+			},
+			{
+				Type: slack.DividerBlock,
+			},
+			{
+				Type: "context",
+				Elements: []slack.Element{
+					{
+						Type:     "image",
+						ImageURL: "https://example.com/icon.png",
+						AltText:  "icon",
+					},
+				},
+			},
+		},
+	}
+
+	data, err := json.Marshal(message)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"blocks": [
+			{
+				"type": "image",
+				"image_url": "https://example.com/status.png",
+				"alt_text": "deploy status",
+				"title": {"type": "plain_text", "text": "Status"}
+			},
+			{
+				"type": "divider"
+			},
+			{
+				"type": "context",
+				"elements": [
+					{"type": "image", "image_url": "https://example.com/icon.png", "alt_text": "icon"}
+				]
+			}
+		]
+	}`, string(data))
+}
+
+func TestAttachments(t *testing.T) {
+	message := slack.NewMessageBuilder().
+		Text("Deploy failed").
+		Attachment(slack.Attachment{
+			Color: "#e01e5a",
+			Title: "api",
+			Text:  "Deploy of v1.4.2 failed",
+			Fields: []slack.AttachmentField{
+				{Title: "Environment", Value: "production", Short: true},
+				{Title: "Duration", Value: "3m12s", Short: true},
+			},
+			Footer: "deploy-bot",
+			Ts:     1234567890,
+		}).
+		Build()
+
+	data, err := json.Marshal(message)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"text": "Deploy failed",
+		"attachments": [
+			{
+				"color": "#e01e5a",
+				"title": "api",
+				"text": "Deploy of v1.4.2 failed",
+				"fields": [
+					{"title": "Environment", "value": "production", "short": true},
+					{"title": "Duration", "value": "3m12s", "short": true}
+				],
+				"footer": "deploy-bot",
+				"ts": 1234567890
+			}
+		]
+	}`, string(data))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/chat.update", r.URL.Path)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		attachments, ok := body["attachments"].([]any)
+		assert.True(t, ok)
+		assert.Len(t, attachments, 1)
+		w.Write([]byte(`{"ok": true, "channel": "C123", "ts": "1234567890.123456"}`))
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+	_, err = client.UpdateMessage(slack.MessageRef{Channel: "C123", Timestamp: "1234567890.123456"}, message)
+	assert.NoError(t, err)
+}
+
+func TestUploadCancellation(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	t.Run("context cancelled mid-upload", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := slack.New(
+			slack.WithToken("test-token"),
+			slack.WithContext(ctx),
+			slack.WithBaseURL(server.URL+"/api"),
+		)
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		err := client.UploadFileWithContent("text", "test.txt", "Test File", "Hello World", slack.MessageRef{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		client := slack.New(
+			slack.WithToken("test-token"),
+			slack.WithBaseURL(server.URL+"/api"),
+			slack.WithHTTPClient(&http.Client{}),
+			slack.WithTimeout(50*time.Millisecond),
+		)
+
+		start := time.Now()
+		err := client.UploadFileWithContent("text", "test.txt", "Test File", "Hello World", slack.MessageRef{})
+		assert.Error(t, err)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
+func TestListBotChannels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/users.conversations", r.URL.Path)
+		assert.NoError(t, r.ParseForm())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.PostForm.Get("cursor") == "" {
+			w.Write([]byte(`{
+				"ok": true,
+				"channels": [{"id": "C1"}, {"id": "C2"}],
+				"response_metadata": {"next_cursor": "page-2"}
+			}`))
+			return
+		}
+		assert.Equal(t, "page-2", r.PostForm.Get("cursor"))
+		w.Write([]byte(`{
+			"ok": true,
+			"channels": [{"id": "C3"}],
+			"response_metadata": {"next_cursor": ""}
+		}`))
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	channels, err := client.ListBotChannels()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"C1", "C2", "C3"}, channels)
+}
+
+func TestGetChannelIDByName(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/conversations.list", r.URL.Path)
+		assert.NoError(t, r.ParseForm())
+		calls++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.PostForm.Get("cursor") == "" {
+			w.Write([]byte(`{
+				"ok": true,
+				"channels": [{"id": "C1", "name": "general"}],
+				"response_metadata": {"next_cursor": "page-2"}
+			}`))
+			return
+		}
+		w.Write([]byte(`{
+			"ok": true,
+			"channels": [{"id": "C2", "name": "deploys"}],
+			"response_metadata": {"next_cursor": ""}
+		}`))
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	id, err := client.GetChannelIDByName("#deploys")
+	assert.NoError(t, err)
+	assert.Equal(t, "C2", id)
+	assert.Equal(t, 2, calls)
+
+	id, err = client.GetChannelIDByName("general")
+	assert.NoError(t, err)
+	assert.Equal(t, "C1", id)
+	assert.Equal(t, 2, calls, "cached names should not list the channels again")
+
+	_, err = client.GetChannelIDByName("missing")
+	var channelErr *slack.ErrInvalidChannel
+	assert.ErrorAs(t, err, &channelErr)
+	assert.Equal(t, "missing", channelErr.Value)
+	assert.Equal(t, 4, calls)
+
+	_, err = client.GetChannelIDByName("missing")
+	assert.ErrorAs(t, err, &channelErr)
+	assert.Equal(t, 4, calls, "unknown names should be cached")
+
+	_, err = client.GetChannelIDByName("#")
+	assert.ErrorAs(t, err, &channelErr)
+}
+
+func TestGetChannelHistory(t *testing.T) {
+	var limits []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/conversations.history", r.URL.Path)
+		assert.NoError(t, r.ParseForm())
+		limits = append(limits, r.PostForm.Get("limit"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.PostForm.Get("channel") != "C1" {
+			w.Write([]byte(`{"ok": false, "error": "channel_not_found"}`))
+			return
+		}
+		if r.PostForm.Get("cursor") == "" {
+			w.Write([]byte(`{
+				"ok": true,
+				"messages": [
+					{"type": "message", "user": "U1", "text": "third", "ts": "3.0"},
+					{"type": "message", "user": "U2", "text": "second", "ts": "2.0", "thread_ts": "1.0"}
+				],
+				"has_more": true,
+				"response_metadata": {"next_cursor": "page-2"}
+			}`))
+			return
+		}
+		w.Write([]byte(`{
+			"ok": true,
+			"messages": [{"type": "message", "user": "U1", "text": "first", "ts": "1.0", "thread_ts": "1.0"}],
+			"has_more": true,
+			"response_metadata": {"next_cursor": "page-3"}
+		}`))
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+	)
+
+	messages, err := client.GetChannelHistory("C1", 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []slack.HistoryMessage{
+		{Type: "message", User: "U1", Text: "third", Ts: "3.0"},
+		{Type: "message", User: "U2", Text: "second", Ts: "2.0", ThreadTs: "1.0"},
+		{Type: "message", User: "U1", Text: "first", Ts: "1.0", ThreadTs: "1.0"},
+	}, messages)
+	assert.Equal(t, []string{"3", "1"}, limits, "pages should stop at the limit")
+
+	_, err = client.GetChannelHistory("C404", 10)
+	var channelErr *slack.ErrInvalidChannel
+	assert.ErrorAs(t, err, &channelErr)
+
+	_, err = client.GetChannelHistory("", 10)
+	assert.ErrorAs(t, err, &channelErr)
+}
+
+func TestWithAutoResolveChannels(t *testing.T) {
+	var listCalls int
+	var postedChannels []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/api/conversations.list":
+			listCalls++
+			w.Write([]byte(`{
+				"ok": true,
+				"channels": [{"id": "C0123456789", "name": "deploys"}],
+				"response_metadata": {"next_cursor": ""}
+			}`))
+		case "/api/chat.postMessage":
+			var message slack.Message
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+			postedChannels = append(postedChannels, message.Channel)
+			w.Write([]byte(fmt.Sprintf(`{"ok": true, "channel": %q, "ts": "1.0"}`, message.Channel)))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := slack.New(
+		slack.WithToken("test-token"),
+		slack.WithBaseURL(server.URL+"/api"),
+		slack.WithAutoResolveChannels(),
+	)
+
+	_, err := client.SendText("#deploys", "one")
+	assert.NoError(t, err)
+	_, err = client.SendText("deploys", "two")
+	assert.NoError(t, err)
+	_, err = client.SendText("G0123456789", "three")
+	assert.NoError(t, err)
+	_, err = client.SendText("U0123456789", "direct")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"C0123456789", "C0123456789", "G0123456789", "U0123456789"}, postedChannels)
+	assert.Equal(t, 1, listCalls, "names should be resolved from the cache")
+
+	_, err = client.SendText("#missing", "four")
+	var channelErr *slack.ErrInvalidChannel
+	assert.ErrorAs(t, err, &channelErr)
+	assert.Len(t, postedChannels, 4)
+}
@@ -14,6 +14,8 @@ const (
 	HeaderBlock   BlockType = "header"
 	ActionsBlock  BlockType = "actions"
 	RichTextBlock BlockType = "rich_text"
+	ImageBlock    BlockType = "image"
+	DividerBlock  BlockType = "divider"
 )
 
 // TextType represents the type of text in a Slack message
@@ -36,10 +38,18 @@ const (
 
 // Message represents a Slack message
 type Message struct {
-	Channel string  `json:"channel,omitempty"`
-	Thread  string  `json:"thread_ts,omitempty"`
-	Text    string  `json:"text,omitempty"`
-	Blocks  []Block `json:"blocks,omitempty"`
+	Channel        string       `json:"channel,omitempty"`
+	Thread         string       `json:"thread_ts,omitempty"`
+	ReplyBroadcast bool         `json:"reply_broadcast,omitempty"`
+	Text           string       `json:"text,omitempty"`
+	Blocks         []Block      `json:"blocks,omitempty"`
+	Attachments    []Attachment `json:"attachments,omitempty"`
+}
+
+// ephemeralMessage is a message only visible to User, posted with chat.postEphemeral
+type ephemeralMessage struct {
+	Message
+	User string `json:"user"`
 }
 
 // Text represents text content in a Slack message
@@ -63,6 +73,8 @@ type Element struct {
 	Value    string `json:"value,omitempty"`
 	Elements []Text `json:"elements,omitempty"`
 	ActionId string `json:"action_id,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	AltText  string `json:"alt_text,omitempty"`
 }
 
 // Block represents a block in a Slack message
@@ -72,6 +84,27 @@ type Block struct {
 	Fields   []Field   `json:"fields,omitempty"`
 	Elements []Element `json:"elements,omitempty"`
 	BlockId  string    `json:"block_id,omitempty"`
+	ImageURL string    `json:"image_url,omitempty"`
+	AltText  string    `json:"alt_text,omitempty"`
+	Title    *Text     `json:"title,omitempty"`
+}
+
+// Attachment represents a legacy message attachment, shown as a card with a colored bar
+type Attachment struct {
+	Color  string            `json:"color,omitempty"`
+	Title  string            `json:"title,omitempty"`
+	Text   string            `json:"text,omitempty"`
+	Fields []AttachmentField `json:"fields,omitempty"`
+	Footer string            `json:"footer,omitempty"`
+	Ts     int64             `json:"ts,omitempty"`
+}
+
+// AttachmentField represents a field in a Slack message attachment, shown side by side with
+// the next field when Short is set
+type AttachmentField struct {
+	Title string `json:"title,omitempty"`
+	Value string `json:"value,omitempty"`
+	Short bool   `json:"short,omitempty"`
 }
 
 // SlackResponse handles parsing out errors from the web api.
@@ -93,8 +126,127 @@ type SlackResponseMetadata struct {
 // FileUploadResponse represents a response from Slack's files.upload API
 type FileUploadResponse struct {
 	SlackResponse
-	File struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-	} `json:"file"`
+	File UploadedFile `json:"file"`
+}
+
+// UploadedFile represents a file uploaded to Slack
+type UploadedFile struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Title           string `json:"title"`
+	Permalink       string `json:"permalink"`
+	PermalinkPublic string `json:"permalink_public"`
+}
+
+// FileUpload describes a file uploaded with UploadFile
+type FileUpload struct {
+	FileName       string  // FileName is the name of the file, required
+	Title          string  // Title is the title of the file, the file name when empty
+	Content        string  // Content of the file, nothing is uploaded when empty
+	SnippetType    string  // SnippetType is the syntax type of a snippet, e.g. "python", optional
+	InitialComment string  // InitialComment is the mrkdwn message shared with the file, optional
+	Blocks         []Block // Blocks are the blocks of the message shared with the file, optional
+}
+
+// uploadURLResponse represents a response from Slack's files.getUploadURLExternal API
+type uploadURLResponse struct {
+	SlackResponse
+	UploadURL string `json:"upload_url"`
+	FileID    string `json:"file_id"`
+}
+
+// completeUploadResponse represents a response from Slack's files.completeUploadExternal API
+type completeUploadResponse struct {
+	SlackResponse
+	Files []UploadedFile `json:"files"`
+}
+
+// AuthInfo represents the identity of the token used by the client
+type AuthInfo struct {
+	URL    string `json:"url"`
+	Team   string `json:"team"`
+	User   string `json:"user"`
+	TeamID string `json:"team_id"`
+	UserID string `json:"user_id"`
+	BotID  string `json:"bot_id"`
+}
+
+// AuthTestResponse represents a response from Slack's auth.test API
+type AuthTestResponse struct {
+	SlackResponse
+	AuthInfo
+}
+
+// Reaction represents a reaction emoji on a Slack message and the users who reacted with it
+type Reaction struct {
+	Name  string   `json:"name"`
+	Count int      `json:"count"`
+	Users []string `json:"users"`
+}
+
+// ReactionsResponse represents a response from Slack's reactions.get API
+type ReactionsResponse struct {
+	SlackResponse
+	Message struct {
+		Reactions []Reaction `json:"reactions"`
+	} `json:"message"`
+}
+
+// User represents a Slack user
+type User struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	RealName string `json:"real_name"`
+	Email    string `json:"email"`
+}
+
+// userResponse represents a response from Slack's users.lookupByEmail API, which nests the
+// email in the profile of the user
+type userResponse struct {
+	SlackResponse
+	User struct {
+		User
+		Profile struct {
+			Email string `json:"email"`
+		} `json:"profile"`
+	} `json:"user"`
+}
+
+// Conversation represents a Slack channel, group or direct message
+type Conversation struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	IsChannel  bool   `json:"is_channel"`
+	IsPrivate  bool   `json:"is_private"`
+	IsArchived bool   `json:"is_archived"`
+	IsMember   bool   `json:"is_member"`
+}
+
+// ConversationsResponse represents a paginated list of conversations returned by Slack
+type ConversationsResponse struct {
+	SlackResponse
+	Channels []Conversation `json:"channels"`
+}
+
+// HistoryMessage is a message of a channel history
+type HistoryMessage struct {
+	Type     string `json:"type"`
+	User     string `json:"user"`
+	Text     string `json:"text"`
+	Ts       string `json:"ts"`
+	ThreadTs string `json:"thread_ts"`
+}
+
+// HistoryResponse represents a page of a channel history returned by Slack
+type HistoryResponse struct {
+	SlackResponse
+	Messages []HistoryMessage `json:"messages"`
+	HasMore  bool             `json:"has_more"`
+}
+
+// openConversationResponse represents a response from Slack's conversations.open API, which
+// returns the channel as an object rather than an ID
+type openConversationResponse struct {
+	SlackResponse
+	Channel Conversation `json:"channel"`
 }
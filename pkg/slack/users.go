@@ -0,0 +1,38 @@
+package slack
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+)
+
+// LookupUserByEmail returns the Slack user with the given email address. The token needs the
+// users:read.email scope.
+func (s *slack) LookupUserByEmail(email string) (*User, error) {
+	if email == "" {
+		return nil, &ErrInvalidUser{Value: "email is empty"}
+	}
+	values := url.Values{}
+	values.Set("email", email)
+	resp, err := s.getRequest("users.lookupByEmail", nil, values)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var response userResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, err
+	}
+	if !response.Ok {
+		if response.Error == "users_not_found" {
+			return nil, &ErrUserNotFound{Value: email}
+		}
+		return nil, responseError(response.SlackResponse, "")
+	}
+	user := response.User.User
+	user.Email = response.User.Profile.Email
+	return &user, nil
+}